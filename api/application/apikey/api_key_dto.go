@@ -0,0 +1,36 @@
+package apikey
+
+import (
+	"api/domain/apikey"
+	"time"
+)
+
+type ApiKeyDto struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Scopes    []string   `json:"scopes"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// 発行したときだけキー全体を返す
+type IssuedApiKeyDto struct {
+	ApiKeyDto
+	Key string `json:"key"`
+}
+
+func newApiKeyDto(k *apikey.ApiKey) ApiKeyDto {
+	scopes := make([]string, 0, len(k.GetScopes()))
+	for _, s := range k.GetScopes() {
+		scopes = append(scopes, string(s))
+	}
+	return ApiKeyDto{
+		ID:        k.GetId(),
+		Name:      k.GetName(),
+		Prefix:    k.GetPrefix(),
+		Scopes:    scopes,
+		RevokedAt: k.GetRevokedAt(),
+		CreatedAt: k.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,76 @@
+package apikey
+
+import (
+	"api/domain/apikey"
+	"context"
+	"fmt"
+	"time"
+)
+
+type IApiKeyUseCase interface {
+	Issue(ctx context.Context, request *IssueApiKeyRequestDto) (*IssuedApiKeyDto, error)
+	List(ctx context.Context, userId string) ([]ApiKeyDto, error)
+	Revoke(ctx context.Context, id int, userId string) error
+}
+
+type ApiKeyUseCase struct {
+	apiKeyRepository apikey.ApiKeyRepository
+}
+
+func NewApiKeyUseCase(apiKeyRepository apikey.ApiKeyRepository) *ApiKeyUseCase {
+	return &ApiKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+type IssueApiKeyRequestDto struct {
+	UserID string
+	Name   string
+	Scopes []string
+}
+
+// Issue キーを発行する。キー全体を返すのはこのときだけで、保存するのはハッシュのみ
+func (u *ApiKeyUseCase) Issue(ctx context.Context, request *IssueApiKeyRequestDto) (*IssuedApiKeyDto, error) {
+	scopes, err := apikey.ParseScopes(request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	key, prefix, err := apikey.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("APIキー生成エラー: %w", err)
+	}
+
+	newKey, err := apikey.NewApiKey(0, request.UserID, request.Name, prefix, apikey.HashKey(key), scopes, nil, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := u.apiKeyRepository.Create(ctx, newKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedApiKeyDto{
+		ApiKeyDto: newApiKeyDto(created),
+		Key:       key,
+	}, nil
+}
+
+// List 無効化したキーも含めて返す
+func (u *ApiKeyUseCase) List(ctx context.Context, userId string) ([]ApiKeyDto, error) {
+	keys, err := u.apiKeyRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]ApiKeyDto, 0, len(keys))
+	for _, k := range keys {
+		dtos = append(dtos, newApiKeyDto(k))
+	}
+	return dtos, nil
+}
+
+func (u *ApiKeyUseCase) Revoke(ctx context.Context, id int, userId string) error {
+	return u.apiKeyRepository.Revoke(ctx, id, userId)
+}
@@ -0,0 +1,43 @@
+package apikey
+
+import (
+	"api/domain/apikey"
+	domainErr "api/domain/error"
+	"context"
+	"errors"
+)
+
+// 存在しない・無効化されたキー。どちらかは返さない
+var ErrInvalidApiKey = errors.New("invalid api key")
+
+type IAuthenticateApiKeyUseCase interface {
+	Execute(ctx context.Context, key string) (*apikey.ApiKey, error)
+}
+
+type AuthenticateApiKeyUseCase struct {
+	apiKeyRepository apikey.ApiKeyRepository
+}
+
+func NewAuthenticateApiKeyUseCase(apiKeyRepository apikey.ApiKeyRepository) *AuthenticateApiKeyUseCase {
+	return &AuthenticateApiKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+func (u *AuthenticateApiKeyUseCase) Execute(ctx context.Context, key string) (*apikey.ApiKey, error) {
+	if !apikey.LooksLikeKey(key) {
+		return nil, ErrInvalidApiKey
+	}
+
+	found, err := u.apiKeyRepository.FindByHash(ctx, apikey.HashKey(key))
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return nil, ErrInvalidApiKey
+		}
+		return nil, err
+	}
+	if found.IsRevoked() {
+		return nil, ErrInvalidApiKey
+	}
+	return found, nil
+}
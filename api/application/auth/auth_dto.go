@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"api/domain/user"
+	"time"
+)
+
+type AuthDto struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	User      UserDto   `json:"user"`
+}
+
+type UserDto struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+func newAuthDto(u *user.User, token string, expiresAt time.Time) *AuthDto {
+	return &AuthDto{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		User: UserDto{
+			ID:    u.GetId(),
+			Email: u.GetEmail(),
+			Name:  u.GetName(),
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	domainErr "api/domain/error"
+	"api/domain/user"
+	"context"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// メールアドレスとパスワードのどちらが違うかは返さない
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// 存在しないメールアドレスでも同じだけ時間をかけるための比較用ハッシュ
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+type ILoginUseCase interface {
+	Execute(ctx context.Context, request *LoginRequestDto) (*AuthDto, error)
+}
+
+type LoginUseCase struct {
+	userRepository user.UserRepository
+	tokenIssuer    TokenIssuer
+}
+
+func NewLoginUseCase(userRepository user.UserRepository, tokenIssuer TokenIssuer) *LoginUseCase {
+	return &LoginUseCase{
+		userRepository: userRepository,
+		tokenIssuer:    tokenIssuer,
+	}
+}
+
+type LoginRequestDto struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (u *LoginUseCase) Execute(ctx context.Context, request *LoginRequestDto) (*AuthDto, error) {
+	found, err := u.userRepository.FindByEmail(ctx, normalizeEmail(request.Email))
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(request.Password))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(found.GetPasswordHash()), []byte(request.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	token, expiresAt, err := u.tokenIssuer.Issue(found.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return newAuthDto(found, token, expiresAt), nil
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	domainErr "api/domain/error"
+	"api/domain/user"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// メモリ上にユーザーを保存するリポジトリ
+type memoryUserRepository struct {
+	users map[string]*user.User
+}
+
+func (r *memoryUserRepository) Create(ctx context.Context, u *user.User) error {
+	if _, ok := r.users[u.GetEmail()]; ok {
+		return user.ErrEmailAlreadyExists
+	}
+	r.users[u.GetEmail()] = u
+	return nil
+}
+
+func (r *memoryUserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	u, ok := r.users[email]
+	if !ok {
+		return nil, domainErr.NotFoundErr
+	}
+	return u, nil
+}
+
+func (r *memoryUserRepository) FindById(ctx context.Context, id string) (*user.User, error) {
+	for _, u := range r.users {
+		if u.GetId() == id {
+			return u, nil
+		}
+	}
+	return nil, domainErr.NotFoundErr
+}
+
+type stubTokenIssuer struct{}
+
+func (s *stubTokenIssuer) Issue(userId string) (string, time.Time, error) {
+	return "token-" + userId, time.Time{}, nil
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryUserRepository{users: map[string]*user.User{}}
+	register := NewRegisterUseCase(repo, &stubTokenIssuer{})
+	login := NewLoginUseCase(repo, &stubTokenIssuer{})
+
+	registered, err := register.Execute(ctx, &RegisterRequestDto{Email: " Trainer@Example.com", Name: "サトシ", Password: "pikachu-25"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "trainer@example.com", registered.User.Email)
+	assert.Equal(t, "token-"+registered.User.ID, registered.Token)
+	assert.NotEqual(t, "pikachu-25", repo.users["trainer@example.com"].GetPasswordHash())
+
+	_, err = register.Execute(ctx, &RegisterRequestDto{Email: "trainer@example.com", Name: "カスミ", Password: "starmie-121"})
+	assert.ErrorIs(t, err, user.ErrEmailAlreadyExists)
+
+	tests := map[string]struct {
+		email       string
+		password    string
+		expectError error
+	}{
+		"valid":          {email: "TRAINER@example.com", password: "pikachu-25"},
+		"wrong password": {email: "trainer@example.com", password: "raichu-26", expectError: ErrInvalidCredentials},
+		"unknown email":  {email: "gary@example.com", password: "pikachu-25", expectError: ErrInvalidCredentials},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := login.Execute(ctx, &LoginRequestDto{Email: tt.email, Password: tt.password})
+			if tt.expectError != nil {
+				assert.True(t, errors.Is(err, tt.expectError), "expected %v, got %v", tt.expectError, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, registered.User.ID, result.User.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"api/domain/user"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type IRegisterUseCase interface {
+	Execute(ctx context.Context, request *RegisterRequestDto) (*AuthDto, error)
+}
+
+type RegisterUseCase struct {
+	userRepository user.UserRepository
+	tokenIssuer    TokenIssuer
+}
+
+func NewRegisterUseCase(userRepository user.UserRepository, tokenIssuer TokenIssuer) *RegisterUseCase {
+	return &RegisterUseCase{
+		userRepository: userRepository,
+		tokenIssuer:    tokenIssuer,
+	}
+}
+
+type RegisterRequestDto struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+func (u *RegisterUseCase) Execute(ctx context.Context, request *RegisterRequestDto) (*AuthDto, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("パスワードのハッシュ化エラー: %w", err)
+	}
+
+	newUser, err := user.NewUser(uuid.NewString(), normalizeEmail(request.Email), request.Name, string(hash))
+	if err != nil {
+		return nil, err
+	}
+
+	// メールアドレスの重複はuser.ErrEmailAlreadyExistsとして返る
+	if err := u.userRepository.Create(ctx, newUser); err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := u.tokenIssuer.Issue(newUser.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return newAuthDto(newUser, token, expiresAt), nil
+}
+
+// 大文字・小文字の違いで別のアカウントが作られないようにする
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,8 @@
+package auth
+
+import "time"
+
+// セッショントークンの発行。検証はpresentationの認証ミドルウェアで行う
+type TokenIssuer interface {
+	Issue(userId string) (token string, expiresAt time.Time, err error)
+}
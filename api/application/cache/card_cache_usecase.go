@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"api/pkg/lru"
+	"context"
+)
+
+// CardCache カード情報のキャッシュ
+type CardCache interface {
+	Stats() lru.Stats
+	Invalidate()
+}
+
+type CardCacheStatsDto struct {
+	// 設定でキャッシュを無効にしている場合はfalse
+	Enabled   bool    `json:"enabled"`
+	Size      int     `json:"size"`
+	Capacity  int     `json:"capacity"`
+	Hits      uint64  `json:"hits"`
+	Misses    uint64  `json:"misses"`
+	Evictions uint64  `json:"evictions"`
+	HitRate   float64 `json:"hit_rate"`
+}
+
+type ICardCacheUseCase interface {
+	GetStats(ctx context.Context) *CardCacheStatsDto
+	// カードを再インポートしたあとに呼び出す
+	Invalidate(ctx context.Context) *CardCacheStatsDto
+}
+
+type CardCacheUseCase struct {
+	cardCache CardCache
+}
+
+// NewCardCacheUseCase キャッシュを無効にしている場合、cardCacheはnil
+func NewCardCacheUseCase(cardCache CardCache) *CardCacheUseCase {
+	return &CardCacheUseCase{
+		cardCache: cardCache,
+	}
+}
+
+func (u *CardCacheUseCase) GetStats(ctx context.Context) *CardCacheStatsDto {
+	if u.cardCache == nil {
+		return &CardCacheStatsDto{}
+	}
+
+	stats := u.cardCache.Stats()
+	return &CardCacheStatsDto{
+		Enabled:   true,
+		Size:      stats.Size,
+		Capacity:  stats.Capacity,
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Evictions: stats.Evictions,
+		HitRate:   stats.HitRate(),
+	}
+}
+
+func (u *CardCacheUseCase) Invalidate(ctx context.Context) *CardCacheStatsDto {
+	if u.cardCache != nil {
+		u.cardCache.Invalidate()
+	}
+	return u.GetStats(ctx)
+}
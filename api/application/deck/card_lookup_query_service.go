@@ -0,0 +1,27 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"context"
+)
+
+// CardPrint テキスト形式のデッキリストと対応させるためのカードの収録情報
+type CardPrint struct {
+	Id         int
+	CardType   domain.CardType
+	Name       string
+	Expansion  string
+	CardNumber string
+}
+
+type CardLookupQueryService interface {
+	// エキスパンションとカード番号が一致するカードを取得
+	FindByExpansionAndNumber(ctx context.Context, expansion string, cardNumber string) ([]CardPrint, error)
+	// 名前が完全に一致するカードを新しい順に取得
+	FindByName(ctx context.Context, name string) ([]CardPrint, error)
+	// 名前の一部が一致するカードを取得。取り込めなかった行の候補に使う
+	SearchByName(ctx context.Context, name string, limit int) ([]CardPrint, error)
+	// 複数のカードをまとめて取得。見つからないカードは結果に含めない
+	FindByIds(ctx context.Context, keys []domainDeck.CardKey) (map[domainDeck.CardKey]CardPrint, error)
+}
@@ -0,0 +1,91 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	domainErr "api/domain/error"
+	"context"
+	"errors"
+)
+
+var (
+	ErrInvalidMainCardCategory = errors.New("invalid main card category")
+	ErrInvalidSubCardCategory  = errors.New("invalid sub card category")
+	ErrInvalidCardCategory     = errors.New("invalid card category")
+)
+
+// resolvedCards リクエストのカードIDから取得したカード
+type resolvedCards struct {
+	mainCard  domain.Card
+	subCard   domain.Card
+	deckCards []domainDeck.DeckCard
+}
+
+// resolveCards 作成・更新・検証で同じ手順でカードを取得する
+// カードはまとめて1回で取得するので、60枚のデッキでもカードの数だけ問い合わせることはない
+func resolveCards(ctx context.Context, cardRepository domainDeck.CardRepository, mainCardID *CardIDDto, subCardID *CardIDDto, cards []DeckCardRequestDto) (*resolvedCards, error) {
+	var keys []domainDeck.CardKey
+
+	// メインカード（存在する場合）
+	var mainCardKey *domainDeck.CardKey
+	if mainCardID != nil {
+		cardType, exists := domain.StringToCardType[mainCardID.Category]
+		if !exists {
+			return nil, ErrInvalidMainCardCategory
+		}
+		mainCardKey = &domainDeck.CardKey{Id: mainCardID.Id, CardType: cardType}
+		keys = append(keys, *mainCardKey)
+	}
+
+	// サブカード（存在する場合）
+	var subCardKey *domainDeck.CardKey
+	if subCardID != nil {
+		cardType, exists := domain.StringToCardType[subCardID.Category]
+		if !exists {
+			return nil, ErrInvalidSubCardCategory
+		}
+		subCardKey = &domainDeck.CardKey{Id: subCardID.Id, CardType: cardType}
+		keys = append(keys, *subCardKey)
+	}
+
+	// デッキカード
+	cardKeys := make([]domainDeck.CardKey, 0, len(cards))
+	for _, cardRequest := range cards {
+		cardType, exists := domain.StringToCardType[cardRequest.Category]
+		if !exists {
+			return nil, ErrInvalidCardCategory
+		}
+		cardKeys = append(cardKeys, domainDeck.CardKey{Id: cardRequest.Id, CardType: cardType})
+	}
+	keys = append(keys, cardKeys...)
+
+	found, err := cardRepository.FindCardsByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := &resolvedCards{}
+	if mainCardKey != nil {
+		card, ok := found[*mainCardKey]
+		if !ok {
+			return nil, domainErr.NotFoundErr
+		}
+		resolved.mainCard = card
+	}
+	if subCardKey != nil {
+		card, ok := found[*subCardKey]
+		if !ok {
+			return nil, domainErr.NotFoundErr
+		}
+		resolved.subCard = card
+	}
+	for i, key := range cardKeys {
+		card, ok := found[key]
+		if !ok {
+			return nil, domainErr.NotFoundErr
+		}
+		resolved.deckCards = append(resolved.deckCards, *domainDeck.NewDeckCard(card, cards[i].Quantity))
+	}
+
+	return resolved, nil
+}
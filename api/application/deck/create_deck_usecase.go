@@ -1,10 +1,9 @@
 package deck
 
 import (
-	"api/domain"
 	domainDeck "api/domain/deck"
 	"context"
-	"errors"
+	"time"
 )
 
 type ICreateDeckUseCase interface {
@@ -14,12 +13,14 @@ type ICreateDeckUseCase interface {
 type CreateDeckUseCase struct {
 	deckRepository domainDeck.DeckRepository
 	cardRepository domainDeck.CardRepository
+	now            func() time.Time
 }
 
 func NewCreateDeckUseCase(deckRepository domainDeck.DeckRepository, cardRepository domainDeck.CardRepository) *CreateDeckUseCase {
 	return &CreateDeckUseCase{
 		deckRepository: deckRepository,
 		cardRepository: cardRepository,
+		now:            time.Now,
 	}
 }
 
@@ -29,6 +30,9 @@ type CreateDeckRequestDto struct {
 	MainCardID  *CardIDDto           `json:"main_card,omitempty"`
 	SubCardID   *CardIDDto           `json:"sub_card,omitempty"`
 	Cards       []DeckCardRequestDto `json:"cards"`
+	Format      string               `json:"format,omitempty"`
+	// 認証したユーザーのID。リクエストボディからは受け取らない
+	OwnerID string `json:"-"`
 }
 
 type CardIDDto struct {
@@ -43,60 +47,29 @@ type DeckCardRequestDto struct {
 }
 
 func (u *CreateDeckUseCase) Execute(ctx context.Context, request *CreateDeckRequestDto) (*DeckDto, error) {
-	// カード情報の取得
-	var mainCard domain.Card
-	var subCard domain.Card
-	var deckCards []domainDeck.DeckCard
-
-	// メインカードの取得（存在する場合）
-	if request.MainCardID != nil {
-		cardType, exists := domain.StringToCardType[request.MainCardID.Category]
-		if !exists {
-			return nil, errors.New("メインカードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.MainCardID.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-		mainCard = card
+	// 省略時は無制限のデッキとして登録する
+	formatName := request.Format
+	if formatName == "" {
+		formatName = domainDeck.FormatUnlimited
 	}
-
-	// サブカードの取得（存在する場合）
-	if request.SubCardID != nil {
-		cardType, exists := domain.StringToCardType[request.SubCardID.Category]
-		if !exists {
-			return nil, errors.New("サブカードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.SubCardID.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-		subCard = card
+	format, err := domainDeck.FindFormat(formatName)
+	if err != nil {
+		return nil, err
 	}
 
-	// デッキカードの取得
-	for _, cardRequest := range request.Cards {
-		cardType, exists := domain.StringToCardType[cardRequest.Category]
-		if !exists {
-			return nil, errors.New("カードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, cardRequest.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-
-		deckCard := domainDeck.NewDeckCard(card, cardRequest.Quantity)
-		deckCards = append(deckCards, *deckCard)
+	cards, err := resolveCards(ctx, u.cardRepository, request.MainCardID, request.SubCardID, request.Cards)
+	if err != nil {
+		return nil, err
 	}
 
-	// デッキの作成
-	deck, errs := domainDeck.NewDeck(0, request.Name, request.Description, mainCard, subCard, deckCards)
-	if errs != nil {
-		errorMsg := ""
-		for _, e := range errs {
-			errorMsg += e.Error() + "; "
+	// 検証結果と一緒に警告も返すため、バリデーションなしで組み立ててから検証する
+	deck := domainDeck.NewDeckWithoutValidation(0, request.OwnerID, request.Name, request.Description, format, cards.mainCard, cards.subCard, cards.deckCards)
+	errs := append(deck.Validate(), deck.ValidateFormat(format, u.now())...)
+	if len(errs) > 0 {
+		return nil, &DeckValidationFailedError{
+			Errors:   toViolationDtos(errs),
+			Warnings: toViolationDtos(deck.Warnings()),
 		}
-		return nil, errors.New(errorMsg)
 	}
 
 	// リポジトリに保存
@@ -144,9 +117,11 @@ func (u *CreateDeckUseCase) Execute(ctx context.Context, request *CreateDeckRequ
 		ID:          createdDeck.GetId(),
 		Name:        createdDeck.GetName(),
 		Description: createdDeck.GetDescription(),
+		Format:      createdDeck.GetFormat().GetName(),
 		MainCard:    mainCardDto,
 		SubCard:     subCardDto,
 		Cards:       deckCardDtos,
+		Warnings:    toViolationDtos(deck.Warnings()),
 	}, nil
 }
 
@@ -14,11 +14,14 @@ import (
 
 // モックカード
 type mockCard struct {
-	id       int
-	name     string
-	cardType int
-	imageUrl string
-	aceSpec  bool
+	id         int
+	name       string
+	cardType   int
+	imageUrl   string
+	regulation string
+	expansion  string
+	aceSpec    bool
+	stage      string
 }
 
 func (m *mockCard) GetId() int {
@@ -37,10 +40,50 @@ func (m *mockCard) GetImageUrl() string {
 	return m.imageUrl
 }
 
+func (m *mockCard) GetRegulation() string {
+	return m.regulation
+}
+
+func (m *mockCard) GetExpansion() string {
+	return m.expansion
+}
+
 func (m *mockCard) IsAceSpec() bool {
 	return m.aceSpec
 }
 
+func (m *mockCard) IsRadiant() bool {
+	return false
+}
+
+func (m *mockCard) IsPrismStar() bool {
+	return false
+}
+
+func (m *mockCard) GetStage() string {
+	return m.stage
+}
+
+func (m *mockCard) GetEvolvesFrom() string {
+	return ""
+}
+
+func (m *mockCard) IsBasic() bool {
+	return m.stage == "たね"
+}
+
+func (m *mockCard) IsStageKnown() bool {
+	return m.stage != ""
+}
+
+func (m *mockCard) GetEnergyType() string {
+	return "雷"
+}
+
+func (m *mockCard) HasRuleBox() bool {
+	return false
+}
+
 // モックデッキリポジトリ
 type mockDeckRepository struct {
 	mock.Mock
@@ -62,12 +105,20 @@ func (m *mockDeckRepository) FindById(ctx context.Context, id int) (*domainDeck.
 	return args.Get(0).(*domainDeck.Deck), args.Error(1)
 }
 
-func (m *mockDeckRepository) FindAll(ctx context.Context) ([]*domainDeck.Deck, error) {
-	args := m.Called(ctx)
+func (m *mockDeckRepository) FindPage(ctx context.Context, condition domainDeck.ListCondition) (*domainDeck.DeckPage, error) {
+	args := m.Called(ctx, condition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainDeck.DeckPage), args.Error(1)
+}
+
+func (m *mockDeckRepository) FindSummaryPage(ctx context.Context, condition domainDeck.ListCondition) (*domainDeck.DeckSummaryPage, error) {
+	args := m.Called(ctx, condition)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*domainDeck.Deck), args.Error(1)
+	return args.Get(0).(*domainDeck.DeckSummaryPage), args.Error(1)
 }
 
 func (m *mockDeckRepository) Update(ctx context.Context, d *domainDeck.Deck) error {
@@ -96,6 +147,19 @@ func (m *mockCardRepository) FindCardById(ctx context.Context, id int, cardType
 	return args.Get(0).(domain.Card), args.Error(1)
 }
 
+// まとめて取得する場合も FindCardById のモックの設定を使う
+func (m *mockCardRepository) FindCardsByIds(ctx context.Context, keys []domainDeck.CardKey) (map[domainDeck.CardKey]domain.Card, error) {
+	cards := map[domainDeck.CardKey]domain.Card{}
+	for _, k := range keys {
+		card, err := m.FindCardById(ctx, k.Id, k.CardType)
+		if err != nil {
+			return nil, err
+		}
+		cards[k] = card
+	}
+	return cards, nil
+}
+
 func TestExecute(t *testing.T) {
 	// テストケースの定義
 	tests := map[string]struct {
@@ -137,17 +201,17 @@ func TestExecute(t *testing.T) {
 				},
 			},
 			mockCards: map[string]domain.Card{
-				"pokemon": &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false},
+				"pokemon": &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false, stage: "たね"},
 				"trainer": &mockCard{id: 2, name: "博士の研究", cardType: 2, imageUrl: "professor.jpg", aceSpec: false},
 				"energy":  &mockCard{id: 3, name: "基本電気エネルギー", cardType: 3, imageUrl: "energy.jpg", aceSpec: false},
 			},
 			returnDeck: func() *domainDeck.Deck {
 				// モックカードを作成
-				mainCard := &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false}
+				mainCard := &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false, stage: "たね"}
 				subCard := &mockCard{id: 2, name: "博士の研究", cardType: 2, imageUrl: "professor.jpg", aceSpec: false}
 
 				// モックデッキカードを作成
-				card1 := &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false}
+				card1 := &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false, stage: "たね"}
 				card2 := &mockCard{id: 2, name: "博士の研究", cardType: 2, imageUrl: "professor.jpg", aceSpec: false}
 				card3 := &mockCard{id: 3, name: "基本電気エネルギー", cardType: 3, imageUrl: "energy.jpg", aceSpec: false}
 
@@ -158,7 +222,7 @@ func TestExecute(t *testing.T) {
 				deckCards := []domainDeck.DeckCard{*deckCard1, *deckCard2, *deckCard3}
 
 				// デッキを作成
-				deck, _ := domainDeck.NewDeck(1, "テストデッキ", "テスト用のデッキです", mainCard, subCard, deckCards)
+				deck, _ := domainDeck.NewDeck(1, "", "テストデッキ", "テスト用のデッキです", nil, mainCard, subCard, deckCards)
 				return deck
 			}(),
 			expectError: false,
@@ -241,7 +305,7 @@ func TestExecute(t *testing.T) {
 				},
 			},
 			mockCards: map[string]domain.Card{
-				"pokemon": &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false},
+				"pokemon": &mockCard{id: 1, name: "ピカチュウ", cardType: 1, imageUrl: "pikachu.jpg", aceSpec: false, stage: "たね"},
 				"trainer": &mockCard{id: 2, name: "博士の研究", cardType: 2, imageUrl: "professor.jpg", aceSpec: false},
 				"energy":  &mockCard{id: 3, name: "基本電気エネルギー", cardType: 3, imageUrl: "energy.jpg", aceSpec: false},
 			},
@@ -0,0 +1,97 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"api/pkg/deckcode"
+	"context"
+	"fmt"
+)
+
+type IDeckCodeUseCase interface {
+	GetCode(ctx context.Context, id int) (*DeckCodeDto, error)
+	CreateFromCode(ctx context.Context, request *CreateDeckFromCodeRequestDto) (*DeckDto, error)
+}
+
+type DeckCodeUseCase struct {
+	deckRepository    domainDeck.DeckRepository
+	createDeckUseCase ICreateDeckUseCase
+}
+
+func NewDeckCodeUseCase(deckRepository domainDeck.DeckRepository, createDeckUseCase ICreateDeckUseCase) *DeckCodeUseCase {
+	return &DeckCodeUseCase{
+		deckRepository:    deckRepository,
+		createDeckUseCase: createDeckUseCase,
+	}
+}
+
+type DeckCodeDto struct {
+	Code      string `json:"code"`
+	CardCount int    `json:"card_count"`
+}
+
+type CreateDeckFromCodeRequestDto struct {
+	Code        string
+	Name        string
+	Description string
+	Format      string
+	OwnerID     string
+}
+
+// GetCode デッキカードの構成だけをコードにする。デッキIDや名前は含めない
+func (u *DeckCodeUseCase) GetCode(ctx context.Context, id int) (*DeckCodeDto, error) {
+	deck, err := u.deckRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []deckcode.Entry
+	cardCount := 0
+	for _, c := range deck.GetCards() {
+		entries = append(entries, deckcode.Entry{
+			CardType: c.GetCard().GetCardType(),
+			CardID:   c.GetCard().GetId(),
+			Quantity: c.GetQuantity(),
+		})
+		cardCount += c.GetQuantity()
+	}
+
+	code, err := deckcode.Encode(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeckCodeDto{
+		Code:      code,
+		CardCount: cardCount,
+	}, nil
+}
+
+// CreateFromCode コードを読み取り、デッキ作成と同じ検証をしてから保存する
+func (u *DeckCodeUseCase) CreateFromCode(ctx context.Context, request *CreateDeckFromCodeRequestDto) (*DeckDto, error) {
+	entries, err := deckcode.Decode(request.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []DeckCardRequestDto
+	for _, e := range entries {
+		category, ok := domain.CardTypeToString[domain.CardType(e.CardType)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown card type %d", deckcode.ErrInvalidCode, e.CardType)
+		}
+		cards = append(cards, DeckCardRequestDto{
+			Id:       e.CardID,
+			Category: category,
+			Quantity: e.Quantity,
+		})
+	}
+
+	return u.createDeckUseCase.Execute(ctx, &CreateDeckRequestDto{
+		Name:        request.Name,
+		Description: request.Description,
+		Format:      request.Format,
+		Cards:       cards,
+		OwnerID:     request.OwnerID,
+	})
+}
@@ -1,12 +1,45 @@
 package deck
 
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"time"
+)
+
 type DeckDto struct {
 	ID          int                  `json:"id"`
 	Name        string               `json:"name"`
 	Description string               `json:"description"`
+	Format      string               `json:"format"`
 	MainCard    *CardDto             `json:"main_card,omitempty"`
 	SubCard     *CardDto             `json:"sub_card,omitempty"`
 	Cards       []DeckCardWithQtyDto `json:"cards"`
+	// 持ち主のいないデッキ。読み取り専用で、誰も編集・削除できない
+	Unowned bool `json:"unowned,omitempty"`
+	// 作成・更新時のみ設定される
+	Warnings []ViolationDto `json:"warnings,omitempty"`
+}
+
+type DeckPageDto struct {
+	Decks      []*DeckDto `json:"decks"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+// DeckSummaryDto 一覧表示用にカードリストの代わりに枚数だけを持つ
+type DeckSummaryDto struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Format      string   `json:"format"`
+	MainCard    *CardDto `json:"main_card,omitempty"`
+	SubCard     *CardDto `json:"sub_card,omitempty"`
+	CardCount   int      `json:"card_count"`
+	Unowned     bool     `json:"unowned,omitempty"`
+}
+
+type DeckSummaryPageDto struct {
+	Decks      []*DeckSummaryDto `json:"decks"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 type CardDto struct {
@@ -23,3 +56,83 @@ type DeckCardWithQtyDto struct {
 	ImageURL string `json:"image_url"`
 	Quantity int    `json:"quantity"`
 }
+
+type ViolationDto struct {
+	Code     string             `json:"code"`
+	Severity string             `json:"severity"`
+	Message  string             `json:"message"`
+	Cards    []ViolationCardDto `json:"cards,omitempty"`
+	Params   map[string]any     `json:"params,omitempty"`
+}
+
+type ViolationCardDto struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Quantity int    `json:"quantity"`
+}
+
+type DeckVersionDto struct {
+	Version   int       `json:"version"`
+	Name      string    `json:"name"`
+	CardCount int       `json:"card_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DeckVersionDetailDto struct {
+	DeckVersionDto
+	Deck *DeckDto `json:"deck"`
+}
+
+type DeckDiffDto struct {
+	DeckID  int           `json:"deck_id"`
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Added   []CardDiffDto `json:"added"`
+	Removed []CardDiffDto `json:"removed"`
+	Changed []CardDiffDto `json:"changed"`
+}
+
+type CardDiffDto struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	ImageURL string `json:"image_url"`
+	Before   int    `json:"before"`
+	After    int    `json:"after"`
+}
+
+func newDeckDto(d *domainDeck.Deck) *DeckDto {
+	dto := &DeckDto{
+		ID:          d.GetId(),
+		Name:        d.GetName(),
+		Description: d.GetDescription(),
+		Format:      d.GetFormat().GetName(),
+		Unowned:     d.GetOwnerId() == "",
+	}
+	if d.GetMainCard() != nil {
+		dto.MainCard = newCardDto(d.GetMainCard())
+	}
+	if d.GetSubCard() != nil {
+		dto.SubCard = newCardDto(d.GetSubCard())
+	}
+	for _, c := range d.GetCards() {
+		dto.Cards = append(dto.Cards, DeckCardWithQtyDto{
+			ID:       c.GetCard().GetId(),
+			Name:     c.GetCard().GetName(),
+			Category: getCardCategory(c.GetCard().GetCardType()),
+			ImageURL: c.GetCard().GetImageUrl(),
+			Quantity: c.GetQuantity(),
+		})
+	}
+	return dto
+}
+
+func newCardDto(c domain.Card) *CardDto {
+	return &CardDto{
+		ID:       c.GetId(),
+		Name:     c.GetName(),
+		Category: getCardCategory(c.GetCardType()),
+		ImageURL: c.GetImageUrl(),
+	}
+}
@@ -0,0 +1,94 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidProbabilityParams = errors.New("invalid probability params")
+
+type IDeckProbabilityUseCase interface {
+	Execute(ctx context.Context, id int, request *DeckProbabilityRequestDto) (*DeckProbabilityResponseDto, error)
+}
+
+type DeckProbabilityUseCase struct {
+	deckRepository domainDeck.DeckRepository
+}
+
+func NewDeckProbabilityUseCase(deckRepository domainDeck.DeckRepository) *DeckProbabilityUseCase {
+	return &DeckProbabilityUseCase{
+		deckRepository: deckRepository,
+	}
+}
+
+type DeckProbabilityRequestDto struct {
+	Turn    int
+	AtLeast int
+	Groups  []CardGroupDto
+}
+
+type CardGroupDto struct {
+	Name      string
+	CardNames []string
+}
+
+type DeckProbabilityResponseDto struct {
+	DeckID   int           `json:"deck_id"`
+	DeckSize int           `json:"deck_size"`
+	Turn     int           `json:"turn"`
+	AtLeast  int           `json:"at_least"`
+	Mulligan float64       `json:"mulligan"`
+	Cards    []CardOddsDto `json:"cards"`
+	Groups   []CardOddsDto `json:"groups,omitempty"`
+}
+
+type CardOddsDto struct {
+	Name      string  `json:"name"`
+	Copies    int     `json:"copies"`
+	Opening   float64 `json:"opening"`
+	ByTurn    float64 `json:"by_turn"`
+	AllPrized float64 `json:"all_prized"`
+}
+
+func (u *DeckProbabilityUseCase) Execute(ctx context.Context, id int, request *DeckProbabilityRequestDto) (*DeckProbabilityResponseDto, error) {
+	if request.Turn < 0 || request.AtLeast < 1 {
+		return nil, fmt.Errorf("%w: turn は0以上、at_least は1以上を指定してください", ErrInvalidProbabilityParams)
+	}
+
+	deck, err := u.deckRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := lo.Map(request.Groups, func(g CardGroupDto, _ int) domainDeck.CardGroup {
+		return domainDeck.NewCardGroup(g.Name, g.CardNames)
+	})
+	odds, err := deck.CalculateOdds(request.Turn, request.AtLeast, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	toDto := func(o domainDeck.CardOdds, _ int) CardOddsDto {
+		return CardOddsDto{
+			Name:      o.Name,
+			Copies:    o.Copies,
+			Opening:   o.Opening,
+			ByTurn:    o.ByTurn,
+			AllPrized: o.AllPrized,
+		}
+	}
+
+	return &DeckProbabilityResponseDto{
+		DeckID:   deck.GetId(),
+		DeckSize: odds.DeckSize,
+		Turn:     odds.Turn,
+		AtLeast:  odds.AtLeast,
+		Mulligan: odds.Mulligan,
+		Cards:    lo.Map(odds.Cards, toDto),
+		Groups:   lo.Map(odds.Groups, toDto),
+	}, nil
+}
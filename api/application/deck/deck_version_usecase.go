@@ -0,0 +1,123 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidVersionRange = errors.New("invalid version range")
+
+type IDeckVersionUseCase interface {
+	GetVersions(ctx context.Context, deckId int) ([]DeckVersionDto, error)
+	GetVersion(ctx context.Context, deckId int, version int) (*DeckVersionDetailDto, error)
+	Diff(ctx context.Context, deckId int, from int, to int) (*DeckDiffDto, error)
+}
+
+type DeckVersionUseCase struct {
+	deckRepository        domainDeck.DeckRepository
+	deckVersionRepository domainDeck.DeckVersionRepository
+}
+
+func NewDeckVersionUseCase(deckRepository domainDeck.DeckRepository, deckVersionRepository domainDeck.DeckVersionRepository) *DeckVersionUseCase {
+	return &DeckVersionUseCase{
+		deckRepository:        deckRepository,
+		deckVersionRepository: deckVersionRepository,
+	}
+}
+
+func (u *DeckVersionUseCase) GetVersions(ctx context.Context, deckId int) ([]DeckVersionDto, error) {
+	versions, err := u.deckVersionRepository.FindByDeckId(ctx, deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 履歴がないときは、デッキが存在しないのか一度も更新されていないのかを区別する
+	if len(versions) == 0 {
+		if _, err := u.deckRepository.FindById(ctx, deckId); err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(versions, func(v *domainDeck.DeckVersion, _ int) DeckVersionDto {
+		return newDeckVersionDto(v)
+	}), nil
+}
+
+func (u *DeckVersionUseCase) GetVersion(ctx context.Context, deckId int, version int) (*DeckVersionDetailDto, error) {
+	v, d, err := u.deckVersionRepository.FindByVersion(ctx, deckId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeckVersionDetailDto{
+		DeckVersionDto: newDeckVersionDto(v),
+		Deck:           newDeckDto(d),
+	}, nil
+}
+
+// Diff fromとtoが0のときは、最新バージョンとその1つ前を比べる
+func (u *DeckVersionUseCase) Diff(ctx context.Context, deckId int, from int, to int) (*DeckDiffDto, error) {
+	if to == 0 {
+		versions, err := u.deckVersionRepository.FindByDeckId(ctx, deckId)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			return nil, fmt.Errorf("%w: 比較できるバージョンがありません", ErrInvalidVersionRange)
+		}
+		to = versions[0].GetVersion()
+	}
+	if from == 0 {
+		from = to - 1
+	}
+	if from < 1 || to < 1 {
+		return nil, fmt.Errorf("%w: from と to は1以上を指定してください", ErrInvalidVersionRange)
+	}
+
+	_, fromDeck, err := u.deckVersionRepository.FindByVersion(ctx, deckId, from)
+	if err != nil {
+		return nil, err
+	}
+	_, toDeck, err := u.deckVersionRepository.FindByVersion(ctx, deckId, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := domainDeck.Diff(fromDeck, toDeck)
+	return &DeckDiffDto{
+		DeckID:  deckId,
+		From:    from,
+		To:      to,
+		Added:   toCardDiffDtos(diff.Added),
+		Removed: toCardDiffDtos(diff.Removed),
+		Changed: toCardDiffDtos(diff.Changed),
+	}, nil
+}
+
+func newDeckVersionDto(v *domainDeck.DeckVersion) DeckVersionDto {
+	return DeckVersionDto{
+		Version:   v.GetVersion(),
+		Name:      v.GetName(),
+		CardCount: v.GetCardCount(),
+		CreatedAt: v.GetCreatedAt(),
+	}
+}
+
+func toCardDiffDtos(diffs []domainDeck.CardDiff) []CardDiffDto {
+	dtos := []CardDiffDto{}
+	for _, d := range diffs {
+		dtos = append(dtos, CardDiffDto{
+			ID:       d.Card.GetId(),
+			Name:     d.Card.GetName(),
+			Category: getCardCategory(d.Card.GetCardType()),
+			ImageURL: d.Card.GetImageUrl(),
+			Before:   d.Before,
+			After:    d.After,
+		})
+	}
+	return dtos
+}
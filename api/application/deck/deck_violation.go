@@ -0,0 +1,48 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"errors"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// DeckValidationFailedError 作成・更新時にデッキがルールを満たしていない場合のエラー
+type DeckValidationFailedError struct {
+	Errors   []ViolationDto
+	Warnings []ViolationDto
+}
+
+func (e *DeckValidationFailedError) Error() string {
+	messages := lo.Map(e.Errors, func(v ViolationDto, _ int) string {
+		return v.Message
+	})
+	return strings.Join(messages, "; ")
+}
+
+func toViolationDtos(errs []error) []ViolationDto {
+	return lo.Map(errs, func(err error, _ int) ViolationDto {
+		var violation domainDeck.DeckValidationError
+		if !errors.As(err, &violation) {
+			return ViolationDto{
+				Severity: string(domainDeck.SeverityError),
+				Message:  err.Error(),
+			}
+		}
+		return ViolationDto{
+			Code:     string(violation.Code),
+			Severity: string(violation.Severity),
+			Message:  violation.Error(),
+			Cards: lo.Map(violation.Cards, func(ref domainDeck.CardRef, _ int) ViolationCardDto {
+				return ViolationCardDto{
+					ID:       ref.Id,
+					Name:     ref.Name,
+					Category: getCardCategory(ref.CardType),
+					Quantity: ref.Quantity,
+				}
+			}),
+			Params: violation.Params,
+		}
+	})
+}
@@ -7,7 +7,7 @@ import (
 )
 
 type IDeleteDeckUseCase interface {
-	DeleteDeck(ctx context.Context, deckId int) error
+	DeleteDeck(ctx context.Context, deckId int, userId string) error
 }
 
 type DeleteDeckUseCase struct {
@@ -20,11 +20,14 @@ func NewDeleteDeckUseCase(deckRepository deck.DeckRepository) *DeleteDeckUseCase
 	}
 }
 
-func (u *DeleteDeckUseCase) DeleteDeck(ctx context.Context, deckId int) error {
-	deck, _ := u.deckRepository.FindById(ctx, deckId)
-	if deck == nil {
+func (u *DeleteDeckUseCase) DeleteDeck(ctx context.Context, deckId int, userId string) error {
+	d, _ := u.deckRepository.FindById(ctx, deckId)
+	if d == nil {
 		return errors.New("デッキが見つかりません")
 	}
+	if !d.CanEdit(userId) {
+		return deck.ErrNotDeckOwner
+	}
 	err := u.deckRepository.Delete(ctx, deckId)
 	if err != nil {
 		return err
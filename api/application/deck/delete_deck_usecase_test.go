@@ -0,0 +1,56 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteDeck(t *testing.T) {
+	tests := map[string]struct {
+		ownerId     string
+		userId      string
+		expectError error
+		expectCall  bool
+	}{
+		"owner": {
+			ownerId:    "user-1",
+			userId:     "user-1",
+			expectCall: true,
+		},
+		"other user": {
+			ownerId:     "user-1",
+			userId:      "user-2",
+			expectError: domainDeck.ErrNotDeckOwner,
+		},
+		"unowned deck": {
+			ownerId:     "",
+			userId:      "user-2",
+			expectError: domainDeck.ErrNotDeckOwner,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockDeckRepo := new(mockDeckRepository)
+			d := domainDeck.NewDeckWithoutValidation(1, tt.ownerId, "テストデッキ", "", nil, nil, nil, nil)
+			mockDeckRepo.On("FindById", mock.Anything, 1).Return(d, nil)
+			mockDeckRepo.On("Delete", mock.Anything, 1).Return(nil)
+
+			err := NewDeleteDeckUseCase(mockDeckRepo).DeleteDeck(context.Background(), 1, tt.userId)
+
+			assert.ErrorIs(t, err, tt.expectError)
+			if tt.expectError == nil {
+				assert.NoError(t, err)
+			}
+			if tt.expectCall {
+				mockDeckRepo.AssertCalled(t, "Delete", mock.Anything, 1)
+			} else {
+				mockDeckRepo.AssertNotCalled(t, "Delete", mock.Anything, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"api/pkg/decklist"
+	"context"
+
+	"github.com/samber/lo"
+)
+
+type IExportDeckUseCase interface {
+	Execute(ctx context.Context, id int) (*ExportDeckResponseDto, error)
+}
+
+type ExportDeckUseCase struct {
+	deckRepository         domainDeck.DeckRepository
+	cardLookupQueryService CardLookupQueryService
+}
+
+func NewExportDeckUseCase(deckRepository domainDeck.DeckRepository, cardLookupQueryService CardLookupQueryService) *ExportDeckUseCase {
+	return &ExportDeckUseCase{
+		deckRepository:         deckRepository,
+		cardLookupQueryService: cardLookupQueryService,
+	}
+}
+
+type ExportDeckResponseDto struct {
+	DeckID int    `json:"deck_id"`
+	Name   string `json:"name"`
+	Text   string `json:"text"`
+}
+
+var cardTypeSections = map[domain.CardType]decklist.Section{
+	domain.Pokemon: decklist.SectionPokemon,
+	domain.Trainer: decklist.SectionTrainer,
+	domain.Energy:  decklist.SectionEnergy,
+}
+
+func (u *ExportDeckUseCase) Execute(ctx context.Context, id int) (*ExportDeckResponseDto, error) {
+	deck, err := u.deckRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := lo.Map(deck.GetCards(), func(c domainDeck.DeckCard, _ int) domainDeck.CardKey {
+		return domainDeck.CardKey{Id: c.GetCard().GetId(), CardType: domain.CardType(c.GetCard().GetCardType())}
+	})
+	prints, err := u.cardLookupQueryService.FindByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []decklist.Entry
+	for i, c := range deck.GetCards() {
+		entry := decklist.Entry{
+			Section:  cardTypeSections[keys[i].CardType],
+			Quantity: c.GetQuantity(),
+			Name:     c.GetCard().GetName(),
+		}
+		// カード番号が登録されていないカードは名前だけで出力する
+		if card, ok := prints[keys[i]]; ok {
+			entry.SetCode = card.Expansion
+			entry.Number = card.CardNumber
+		}
+		entries = append(entries, entry)
+	}
+
+	return &ExportDeckResponseDto{
+		DeckID: deck.GetId(),
+		Name:   deck.GetName(),
+		Text:   decklist.Render(entries),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportDeck(t *testing.T) {
+	cards := []domainDeck.DeckCard{
+		*domainDeck.NewDeckCard(&mockCard{id: 10, name: "ピカチュウex", cardType: 1, stage: "たね"}, 4),
+		*domainDeck.NewDeckCard(&mockCard{id: 20, name: "ナンジャモ", cardType: 2}, 4),
+		*domainDeck.NewDeckCard(&mockCard{id: 31, name: "基本雷エネルギー", cardType: 3}, 52),
+	}
+	d := domainDeck.NewDeckWithoutValidation(1, "", "ピカチュウex", "", nil, nil, nil, cards)
+	keys := []domainDeck.CardKey{
+		{Id: 10, CardType: domain.Pokemon},
+		{Id: 20, CardType: domain.Trainer},
+		{Id: 31, CardType: domain.Energy},
+	}
+
+	tests := map[string]struct {
+		prints      map[domainDeck.CardKey]CardPrint
+		lookupErr   error
+		expectText  string
+		expectError bool
+	}{
+		"success": {
+			// カード番号が分からないナンジャモは名前だけで出力する
+			prints: map[domainDeck.CardKey]CardPrint{
+				keys[0]: {Id: 10, CardType: domain.Pokemon, Name: "ピカチュウex", Expansion: "SV1a", CardNumber: "29"},
+				keys[2]: {Id: 31, CardType: domain.Energy, Name: "基本雷エネルギー", Expansion: "SVE", CardNumber: "4"},
+			},
+			expectText: "Pokémon: 4\n4 ピカチュウex SV1a 29\n\n" +
+				"Trainer: 4\n4 ナンジャモ\n\n" +
+				"Energy: 52\n52 基本雷エネルギー SVE 4\n\n" +
+				"Total Cards: 60\n",
+		},
+		"lookup error": {
+			lookupErr:   errors.New("query error"),
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockDeckRepo := new(mockDeckRepository)
+			mockDeckRepo.On("FindById", mock.Anything, 1).Return(d, nil)
+			mockLookup := new(mockCardLookupQueryService)
+			mockLookup.On("FindByIds", mock.Anything, keys).Return(tt.prints, tt.lookupErr)
+
+			result, err := NewExportDeckUseCase(mockDeckRepo, mockLookup).Execute(context.Background(), 1)
+
+			// カードの枚数に関係なく、収録情報はまとめて1回で取得する
+			mockLookup.AssertNumberOfCalls(t, "FindByIds", 1)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, result.DeckID)
+			assert.Equal(t, "ピカチュウex", result.Name)
+			assert.Equal(t, tt.expectText, result.Text)
+		})
+	}
+}
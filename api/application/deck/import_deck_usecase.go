@@ -0,0 +1,207 @@
+package deck
+
+import (
+	"api/domain"
+	"api/pkg/decklist"
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+// 取り込めなかった行に添える候補の数
+const importCandidateLimit = 5
+
+var ErrEmptyDeckList = errors.New("empty deck list")
+
+type IImportDeckUseCase interface {
+	Execute(ctx context.Context, request *ImportDeckRequestDto) (*ImportDeckResponseDto, error)
+}
+
+type ImportDeckUseCase struct {
+	cardLookupQueryService CardLookupQueryService
+	createDeckUseCase      ICreateDeckUseCase
+}
+
+func NewImportDeckUseCase(cardLookupQueryService CardLookupQueryService, createDeckUseCase ICreateDeckUseCase) *ImportDeckUseCase {
+	return &ImportDeckUseCase{
+		cardLookupQueryService: cardLookupQueryService,
+		createDeckUseCase:      createDeckUseCase,
+	}
+}
+
+type ImportDeckRequestDto struct {
+	Text        string
+	Name        string
+	Description string
+	Format      string
+	// すべての行を取り込めた場合はそのままデッキを作成する
+	Save bool
+	// 作成するデッキの持ち主
+	OwnerID string
+}
+
+type ImportDeckResponseDto struct {
+	// そのままデッキ作成APIに渡せる形にしている
+	Deck       *CreateDeckRequestDto `json:"deck"`
+	Resolved   []ImportedCardDto     `json:"resolved"`
+	Unresolved []UnresolvedLineDto   `json:"unresolved"`
+	Created    *DeckDto              `json:"created,omitempty"`
+}
+
+type ImportedCardDto struct {
+	Line       int    `json:"line"`
+	Quantity   int    `json:"quantity"`
+	Text       string `json:"text"`
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	Expansion  string `json:"expansion"`
+	CardNumber string `json:"card_number"`
+}
+
+type UnresolvedLineDto struct {
+	Line int    `json:"line"`
+	Text string `json:"text"`
+	// parse_error: 行の形式が読み取れない、not_found: 一致するカードがない
+	Reason     string             `json:"reason"`
+	Candidates []CardCandidateDto `json:"candidates"`
+}
+
+type CardCandidateDto struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	Expansion  string `json:"expansion"`
+	CardNumber string `json:"card_number"`
+}
+
+func (u *ImportDeckUseCase) Execute(ctx context.Context, request *ImportDeckRequestDto) (*ImportDeckResponseDto, error) {
+	entries, parseErrs := decklist.Parse(request.Text)
+	if len(entries) == 0 && len(parseErrs) == 0 {
+		return nil, ErrEmptyDeckList
+	}
+
+	response := &ImportDeckResponseDto{
+		Deck: &CreateDeckRequestDto{
+			Name:        request.Name,
+			Description: request.Description,
+			Format:      request.Format,
+			Cards:       []DeckCardRequestDto{},
+			OwnerID:     request.OwnerID,
+		},
+		Resolved:   []ImportedCardDto{},
+		Unresolved: []UnresolvedLineDto{},
+	}
+	for _, e := range parseErrs {
+		response.Unresolved = append(response.Unresolved, UnresolvedLineDto{
+			Line:       e.Line,
+			Text:       e.Raw,
+			Reason:     "parse_error",
+			Candidates: []CardCandidateDto{},
+		})
+	}
+
+	for _, entry := range entries {
+		card, err := u.resolve(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		if card == nil {
+			candidates, err := u.cardLookupQueryService.SearchByName(ctx, entry.Name, importCandidateLimit)
+			if err != nil {
+				return nil, err
+			}
+			response.Unresolved = append(response.Unresolved, UnresolvedLineDto{
+				Line:   entry.Line,
+				Text:   entry.String(),
+				Reason: "not_found",
+				Candidates: lo.Map(candidates, func(c CardPrint, _ int) CardCandidateDto {
+					return CardCandidateDto{
+						ID:         c.Id,
+						Name:       c.Name,
+						Category:   domain.CardTypeToString[c.CardType],
+						Expansion:  c.Expansion,
+						CardNumber: c.CardNumber,
+					}
+				}),
+			})
+			continue
+		}
+
+		category := domain.CardTypeToString[card.CardType]
+		response.Resolved = append(response.Resolved, ImportedCardDto{
+			Line:       entry.Line,
+			Quantity:   entry.Quantity,
+			Text:       entry.String(),
+			ID:         card.Id,
+			Name:       card.Name,
+			Category:   category,
+			Expansion:  card.Expansion,
+			CardNumber: card.CardNumber,
+		})
+
+		// 同じカードが複数行に分かれている場合は枚数をまとめる
+		_, index, found := lo.FindIndexOf(response.Deck.Cards, func(c DeckCardRequestDto) bool {
+			return c.Id == card.Id && c.Category == category
+		})
+		if found {
+			response.Deck.Cards[index].Quantity += entry.Quantity
+			continue
+		}
+		response.Deck.Cards = append(response.Deck.Cards, DeckCardRequestDto{
+			Id:       card.Id,
+			Category: category,
+			Quantity: entry.Quantity,
+		})
+	}
+
+	if request.Save && len(response.Unresolved) == 0 {
+		created, err := u.createDeckUseCase.Execute(ctx, response.Deck)
+		if err != nil {
+			return nil, err
+		}
+		response.Created = created
+	}
+
+	return response, nil
+}
+
+// resolve エキスパンションとカード番号で探し、見つからなければ名前で探す。どちらでも見つからなければnilを返す
+// card_number列を追加する前に登録したカードは番号が空のままなので、名前で探す
+func (u *ImportDeckUseCase) resolve(ctx context.Context, entry decklist.Entry) (*CardPrint, error) {
+	if entry.SetCode != "" && entry.Number != "" {
+		cards, err := u.cardLookupQueryService.FindByExpansionAndNumber(ctx, entry.SetCode, entry.Number)
+		if err != nil {
+			return nil, err
+		}
+		if card, ok := pickCard(cards, entry.Section); ok {
+			return &card, nil
+		}
+	}
+
+	cards, err := u.cardLookupQueryService.FindByName(ctx, entry.Name)
+	if err != nil {
+		return nil, err
+	}
+	if card, ok := pickCard(cards, entry.Section); ok {
+		return &card, nil
+	}
+	return nil, nil
+}
+
+// pickCard 見出しとカードの種類が一致するものを優先する
+func pickCard(cards []CardPrint, section decklist.Section) (CardPrint, bool) {
+	if cardType, ok := sectionCardTypes[section]; ok {
+		if card, found := lo.Find(cards, func(c CardPrint) bool { return c.CardType == cardType }); found {
+			return card, true
+		}
+	}
+	return lo.First(cards)
+}
+
+var sectionCardTypes = map[decklist.Section]domain.CardType{
+	decklist.SectionPokemon: domain.Pokemon,
+	decklist.SectionTrainer: domain.Trainer,
+	decklist.SectionEnergy:  domain.Energy,
+}
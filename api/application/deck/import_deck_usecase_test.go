@@ -0,0 +1,191 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// モックカード検索クエリサービス
+type mockCardLookupQueryService struct {
+	mock.Mock
+}
+
+func (m *mockCardLookupQueryService) FindByExpansionAndNumber(ctx context.Context, expansion string, cardNumber string) ([]CardPrint, error) {
+	args := m.Called(ctx, expansion, cardNumber)
+	return args.Get(0).([]CardPrint), args.Error(1)
+}
+
+func (m *mockCardLookupQueryService) FindByName(ctx context.Context, name string) ([]CardPrint, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).([]CardPrint), args.Error(1)
+}
+
+func (m *mockCardLookupQueryService) SearchByName(ctx context.Context, name string, limit int) ([]CardPrint, error) {
+	args := m.Called(ctx, name, limit)
+	return args.Get(0).([]CardPrint), args.Error(1)
+}
+
+func (m *mockCardLookupQueryService) FindByIds(ctx context.Context, keys []domainDeck.CardKey) (map[domainDeck.CardKey]CardPrint, error) {
+	args := m.Called(ctx, keys)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[domainDeck.CardKey]CardPrint), args.Error(1)
+}
+
+// モックデッキ作成ユースケース
+type mockCreateDeckUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateDeckUseCase) Execute(ctx context.Context, request *CreateDeckRequestDto) (*DeckDto, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DeckDto), args.Error(1)
+}
+
+func TestImportDeck(t *testing.T) {
+	pikachu := CardPrint{Id: 10, CardType: domain.Pokemon, Name: "ピカチュウex", Expansion: "SV1a", CardNumber: "29"}
+	iono := CardPrint{Id: 20, CardType: domain.Trainer, Name: "ナンジャモ", Expansion: "SV2a", CardNumber: "91"}
+	energyAsTrainer := CardPrint{Id: 30, CardType: domain.Trainer, Name: "基本雷エネルギー", Expansion: "SV1", CardNumber: "99"}
+	energy := CardPrint{Id: 31, CardType: domain.Energy, Name: "基本雷エネルギー", Expansion: "SVE", CardNumber: "4"}
+	ultraBall := CardPrint{Id: 40, CardType: domain.Trainer, Name: "ハイパーボール", Expansion: "SM1+"}
+
+	tests := map[string]struct {
+		text             string
+		setup            func(m *mockCardLookupQueryService)
+		expectCards      []DeckCardRequestDto
+		expectUnresolved []string
+	}{
+		"set code and number": {
+			text: "Pokémon: 4\n4 ピカチュウex SV1a 29",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByExpansionAndNumber", mock.Anything, "SV1a", "29").Return([]CardPrint{pikachu}, nil)
+			},
+			expectCards: []DeckCardRequestDto{{Id: 10, Category: "pokemon", Quantity: 4}},
+		},
+		"falls back to the name": {
+			text: "Trainer: 4\n4 ナンジャモ PAL 185",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByExpansionAndNumber", mock.Anything, "PAL", "185").Return([]CardPrint{}, nil)
+				m.On("FindByName", mock.Anything, "ナンジャモ").Return([]CardPrint{iono}, nil)
+			},
+			expectCards: []DeckCardRequestDto{{Id: 20, Category: "trainer", Quantity: 4}},
+		},
+		// カード番号を持たないカードは名前で見つかる
+		"card without a card number": {
+			text: "Trainer: 4\n4 ハイパーボール SUM 135",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByExpansionAndNumber", mock.Anything, "SUM", "135").Return([]CardPrint{}, nil)
+				m.On("FindByName", mock.Anything, "ハイパーボール").Return([]CardPrint{ultraBall}, nil)
+			},
+			expectCards: []DeckCardRequestDto{{Id: 40, Category: "trainer", Quantity: 4}},
+		},
+		"prefers the card type of the section": {
+			text: "Energy: 8\n8 基本雷エネルギー",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByName", mock.Anything, "基本雷エネルギー").Return([]CardPrint{energyAsTrainer, energy}, nil)
+			},
+			expectCards: []DeckCardRequestDto{{Id: 31, Category: "energy", Quantity: 8}},
+		},
+		"merges repeated lines": {
+			text: "Energy: 10\n6 基本雷エネルギー\n4 基本雷エネルギー",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByName", mock.Anything, "基本雷エネルギー").Return([]CardPrint{energy}, nil)
+			},
+			expectCards: []DeckCardRequestDto{{Id: 31, Category: "energy", Quantity: 10}},
+		},
+		"unresolved lines": {
+			text: "Pokémon: 1\n1 ピカチュウ\nピカチュウV",
+			setup: func(m *mockCardLookupQueryService) {
+				m.On("FindByName", mock.Anything, "ピカチュウ").Return([]CardPrint{}, nil)
+				m.On("SearchByName", mock.Anything, "ピカチュウ", importCandidateLimit).Return([]CardPrint{pikachu}, nil)
+			},
+			expectCards:      []DeckCardRequestDto{},
+			expectUnresolved: []string{"parse_error", "not_found"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockLookup := new(mockCardLookupQueryService)
+			tt.setup(mockLookup)
+			mockCreate := new(mockCreateDeckUseCase)
+
+			result, err := NewImportDeckUseCase(mockLookup, mockCreate).Execute(context.Background(), &ImportDeckRequestDto{
+				Text: tt.text,
+				Name: "テストデッキ",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectCards, result.Deck.Cards)
+			var reasons []string
+			for _, u := range result.Unresolved {
+				reasons = append(reasons, u.Reason)
+			}
+			assert.Equal(t, tt.expectUnresolved, reasons)
+			assert.Nil(t, result.Created)
+			mockLookup.AssertExpectations(t)
+			mockCreate.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestImportDeck_Save(t *testing.T) {
+	energy := CardPrint{Id: 31, CardType: domain.Energy, Name: "基本雷エネルギー", Expansion: "SVE", CardNumber: "4"}
+	created := &DeckDto{ID: 1, Name: "テストデッキ"}
+
+	tests := map[string]struct {
+		text         string
+		expectCreate bool
+	}{
+		"every line resolved": {
+			text:         "Energy: 60\n60 基本雷エネルギー",
+			expectCreate: true,
+		},
+		"some lines unresolved": {
+			text:         "Energy: 60\n60 基本雷エネルギー\nピカチュウ",
+			expectCreate: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockLookup := new(mockCardLookupQueryService)
+			mockLookup.On("FindByName", mock.Anything, "基本雷エネルギー").Return([]CardPrint{energy}, nil)
+			mockCreate := new(mockCreateDeckUseCase)
+			mockCreate.On("Execute", mock.Anything, mock.AnythingOfType("*deck.CreateDeckRequestDto")).Return(created, nil)
+
+			result, err := NewImportDeckUseCase(mockLookup, mockCreate).Execute(context.Background(), &ImportDeckRequestDto{
+				Text:    tt.text,
+				Name:    "テストデッキ",
+				Save:    true,
+				OwnerID: "user-1",
+			})
+
+			assert.NoError(t, err)
+			if tt.expectCreate {
+				assert.Equal(t, created, result.Created)
+				mockCreate.AssertCalled(t, "Execute", mock.Anything, result.Deck)
+				assert.Equal(t, "user-1", result.Deck.OwnerID)
+			} else {
+				assert.Nil(t, result.Created)
+				mockCreate.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestImportDeck_Empty(t *testing.T) {
+	_, err := NewImportDeckUseCase(new(mockCardLookupQueryService), new(mockCreateDeckUseCase)).Execute(context.Background(), &ImportDeckRequestDto{
+		Text: "Pokémon: 0\n\nTotal Cards: 0",
+	})
+	assert.ErrorIs(t, err, ErrEmptyDeckList)
+}
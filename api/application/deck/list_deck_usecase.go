@@ -1,13 +1,20 @@
 package deck
 
 import (
+	"api/domain"
 	"api/domain/deck"
 	"context"
 	"errors"
+	"fmt"
 )
 
+var ErrInvalidListParams = errors.New("invalid list params")
+
 type IListDeckUseCase interface {
-	GetAllDecks(ctx context.Context) ([]*DeckDto, error)
+	// ログインしているユーザーのデッキだけを返す
+	GetAllDecks(ctx context.Context, userId string, request *ListDeckRequestDto) (*DeckPageDto, error)
+	// カードリストを含まない一覧表示用の概要を返す
+	GetDeckSummaries(ctx context.Context, userId string, request *ListDeckRequestDto) (*DeckSummaryPageDto, error)
 	GetDeckById(ctx context.Context, deckId int) (*DeckDto, error)
 }
 
@@ -21,60 +28,141 @@ func NewListDeckUseCase(deckRepository deck.DeckRepository) *ListDeckUseCase {
 	}
 }
 
-func (u *ListDeckUseCase) GetAllDecks(ctx context.Context) ([]*DeckDto, error) {
-	decks, err := u.deckRepository.FindAll(ctx)
+type ListDeckRequestDto struct {
+	// デッキ名の部分一致
+	Name   string
+	Format string
+	// デッキに入っているカード
+	Card     *CardIDDto
+	MainCard *CardIDDto
+	// created_at, updated_at, name のいずれか。空の場合は created_at
+	Sort string
+	// asc か desc。空の場合は日時は新しい順、名前は昇順
+	Order  string
+	Cursor string
+	Limit  int
+	// 持ち主のいないデッキも一覧に含める。読み取り専用で編集はできない
+	IncludeUnowned bool
+}
+
+func (u *ListDeckUseCase) GetAllDecks(ctx context.Context, userId string, request *ListDeckRequestDto) (*DeckPageDto, error) {
+	condition, err := newListCondition(userId, request)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.deckRepository.FindPage(ctx, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &DeckPageDto{
+		Decks:      []*DeckDto{},
+		NextCursor: page.NextCursor,
+	}
+	for _, d := range page.Decks {
+		dto.Decks = append(dto.Decks, newDeckDto(d))
+	}
+	return dto, nil
+}
+
+func (u *ListDeckUseCase) GetDeckSummaries(ctx context.Context, userId string, request *ListDeckRequestDto) (*DeckSummaryPageDto, error) {
+	condition, err := newListCondition(userId, request)
 	if err != nil {
 		return nil, err
 	}
 
-	var deckDtos []*DeckDto
-	for _, d := range decks {
-		var mainCardDto *CardDto
-		var subCardDto *CardDto
+	page, err := u.deckRepository.FindSummaryPage(ctx, condition)
+	if err != nil {
+		return nil, err
+	}
 
-		// メインカードがある場合は変換
-		if d.GetMainCard() != nil {
-			mainCardDto = &CardDto{
-				ID:       d.GetMainCard().GetId(),
-				Name:     d.GetMainCard().GetName(),
-				Category: getCardCategory(d.GetMainCard().GetCardType()),
-				ImageURL: d.GetMainCard().GetImageUrl(),
-			}
+	dto := &DeckSummaryPageDto{
+		Decks:      []*DeckSummaryDto{},
+		NextCursor: page.NextCursor,
+	}
+	for _, s := range page.Summaries {
+		summary := &DeckSummaryDto{
+			ID:          s.GetId(),
+			Name:        s.GetName(),
+			Description: s.GetDescription(),
+			Format:      s.GetFormat().GetName(),
+			CardCount:   s.GetCardCount(),
+			Unowned:     s.GetOwnerId() == "",
+		}
+		if s.GetMainCard() != nil {
+			summary.MainCard = newCardDto(s.GetMainCard())
+		}
+		if s.GetSubCard() != nil {
+			summary.SubCard = newCardDto(s.GetSubCard())
 		}
+		dto.Decks = append(dto.Decks, summary)
+	}
+	return dto, nil
+}
 
-		// サブカードがある場合は変換
-		if d.GetSubCard() != nil {
-			subCardDto = &CardDto{
-				ID:       d.GetSubCard().GetId(),
-				Name:     d.GetSubCard().GetName(),
-				Category: getCardCategory(d.GetSubCard().GetCardType()),
-				ImageURL: d.GetSubCard().GetImageUrl(),
-			}
+// newListCondition リクエストをリポジトリの検索条件に変換する
+func newListCondition(userId string, request *ListDeckRequestDto) (deck.ListCondition, error) {
+	condition := deck.ListCondition{
+		OwnerId:        userId,
+		IncludeUnowned: request.IncludeUnowned,
+		Name:           request.Name,
+		Cursor:         request.Cursor,
+		Limit:          request.Limit,
+	}
+
+	if request.Format != "" {
+		format, err := deck.FindFormat(request.Format)
+		if err != nil {
+			return condition, fmt.Errorf("%w: %s", ErrInvalidListParams, err.Error())
 		}
+		condition.Format = format.GetName()
+	}
 
-		// デッキカードの変換
-		var deckCardDtos []DeckCardWithQtyDto
-		for _, c := range d.GetCards() {
-			deckCardDtos = append(deckCardDtos, DeckCardWithQtyDto{
-				ID:       c.GetCard().GetId(),
-				Name:     c.GetCard().GetName(),
-				Category: getCardCategory(c.GetCard().GetCardType()),
-				ImageURL: c.GetCard().GetImageUrl(),
-				Quantity: c.GetQuantity(),
-			})
+	if request.Card != nil {
+		cardType, exists := domain.StringToCardType[request.Card.Category]
+		if !exists {
+			return condition, ErrInvalidCardCategory
 		}
+		condition.Card = &deck.CardFilter{Id: request.Card.Id, CardType: cardType}
+	}
 
-		deckDtos = append(deckDtos, &DeckDto{
-			ID:          d.GetId(),
-			Name:        d.GetName(),
-			Description: d.GetDescription(),
-			MainCard:    mainCardDto,
-			SubCard:     subCardDto,
-			Cards:       deckCardDtos,
-		})
+	if request.MainCard != nil {
+		cardType, exists := domain.StringToCardType[request.MainCard.Category]
+		if !exists {
+			return condition, ErrInvalidMainCardCategory
+		}
+		condition.MainCard = &deck.CardFilter{Id: request.MainCard.Id, CardType: cardType}
+	}
+
+	sortKey, ok := deck.FindSortKey(request.Sort)
+	if !ok {
+		return condition, fmt.Errorf("%w: sort は created_at, updated_at, name のいずれかを指定してください", ErrInvalidListParams)
+	}
+	condition.SortKey = sortKey
+
+	switch request.Order {
+	case "":
+		// 日時は新しい順、名前は五十音順をデフォルトにする
+		condition.Descending = sortKey != deck.SortByName
+	case "asc":
+		condition.Descending = false
+	case "desc":
+		condition.Descending = true
+	default:
+		return condition, fmt.Errorf("%w: order は asc か desc を指定してください", ErrInvalidListParams)
+	}
+
+	switch {
+	case request.Limit < 0:
+		return condition, fmt.Errorf("%w: limit は1以上を指定してください", ErrInvalidListParams)
+	case request.Limit == 0:
+		condition.Limit = deck.DefaultListLimit
+	case request.Limit > deck.MaxListLimit:
+		condition.Limit = deck.MaxListLimit
 	}
 
-	return deckDtos, nil
+	return condition, nil
 }
 
 func (u *ListDeckUseCase) GetDeckById(ctx context.Context, deckId int) (*DeckDto, error) {
@@ -123,6 +211,7 @@ func (u *ListDeckUseCase) GetDeckById(ctx context.Context, deckId int) (*DeckDto
 		ID:          d.GetId(),
 		Name:        d.GetName(),
 		Description: d.GetDescription(),
+		Format:      d.GetFormat().GetName(),
 		MainCard:    mainCardDto,
 		SubCard:     subCardDto,
 		Cards:       deckCardDtos,
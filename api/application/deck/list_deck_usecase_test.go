@@ -0,0 +1,108 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAllDecks(t *testing.T) {
+	tests := map[string]struct {
+		request     *ListDeckRequestDto
+		expect      domainDeck.ListCondition
+		expectError error
+	}{
+		"default": {
+			request: &ListDeckRequestDto{},
+			expect: domainDeck.ListCondition{
+				OwnerId:    "user-1",
+				SortKey:    domainDeck.SortByCreatedAt,
+				Descending: true,
+				Limit:      domainDeck.DefaultListLimit,
+			},
+		},
+		"name is ascending by default": {
+			request: &ListDeckRequestDto{Sort: "name", Limit: 500},
+			expect: domainDeck.ListCondition{
+				OwnerId: "user-1",
+				SortKey: domainDeck.SortByName,
+				Limit:   domainDeck.MaxListLimit,
+			},
+		},
+		"filters": {
+			request: &ListDeckRequestDto{
+				Name:     "サーナイト",
+				Format:   "standard",
+				Card:     &CardIDDto{Id: 10, Category: "trainer"},
+				MainCard: &CardIDDto{Id: 20, Category: "pokemon"},
+				Sort:     "updated_at",
+				Order:    "asc",
+				Cursor:   "abc",
+				Limit:    5,
+			},
+			expect: domainDeck.ListCondition{
+				OwnerId:  "user-1",
+				Name:     "サーナイト",
+				Format:   "standard",
+				Card:     &domainDeck.CardFilter{Id: 10, CardType: domain.Trainer},
+				MainCard: &domainDeck.CardFilter{Id: 20, CardType: domain.Pokemon},
+				SortKey:  domainDeck.SortByUpdatedAt,
+				Cursor:   "abc",
+				Limit:    5,
+			},
+		},
+		"include unowned": {
+			request: &ListDeckRequestDto{IncludeUnowned: true},
+			expect: domainDeck.ListCondition{
+				OwnerId:        "user-1",
+				IncludeUnowned: true,
+				SortKey:        domainDeck.SortByCreatedAt,
+				Descending:     true,
+				Limit:          domainDeck.DefaultListLimit,
+			},
+		},
+		"unknown sort": {
+			request:     &ListDeckRequestDto{Sort: "id"},
+			expectError: ErrInvalidListParams,
+		},
+		"unknown order": {
+			request:     &ListDeckRequestDto{Order: "random"},
+			expectError: ErrInvalidListParams,
+		},
+		"unknown format": {
+			request:     &ListDeckRequestDto{Format: "legacy"},
+			expectError: ErrInvalidListParams,
+		},
+		"unknown card category": {
+			request:     &ListDeckRequestDto{Card: &CardIDDto{Id: 1, Category: "item"}},
+			expectError: ErrInvalidCardCategory,
+		},
+		"negative limit": {
+			request:     &ListDeckRequestDto{Limit: -1},
+			expectError: ErrInvalidListParams,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockDeckRepo := new(mockDeckRepository)
+			mockDeckRepo.On("FindPage", mock.Anything, mock.Anything).Return(&domainDeck.DeckPage{NextCursor: "next"}, nil)
+
+			page, err := NewListDeckUseCase(mockDeckRepo).GetAllDecks(context.Background(), "user-1", tt.request)
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				mockDeckRepo.AssertNotCalled(t, "FindPage", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "next", page.NextCursor)
+			assert.Empty(t, page.Decks)
+			mockDeckRepo.AssertCalled(t, "FindPage", mock.Anything, tt.expect)
+		})
+	}
+}
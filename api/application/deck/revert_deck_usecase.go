@@ -0,0 +1,65 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"time"
+)
+
+type IRevertDeckUseCase interface {
+	Execute(ctx context.Context, deckId int, version int, userId string) (*DeckDto, error)
+}
+
+type RevertDeckUseCase struct {
+	deckRepository        domainDeck.DeckRepository
+	deckVersionRepository domainDeck.DeckVersionRepository
+	now                   func() time.Time
+}
+
+func NewRevertDeckUseCase(deckRepository domainDeck.DeckRepository, deckVersionRepository domainDeck.DeckVersionRepository) *RevertDeckUseCase {
+	return &RevertDeckUseCase{
+		deckRepository:        deckRepository,
+		deckVersionRepository: deckVersionRepository,
+		now:                   time.Now,
+	}
+}
+
+// Execute 指定したバージョンの内容で更新する。履歴は消さずに新しいバージョンとして追加される
+func (u *RevertDeckUseCase) Execute(ctx context.Context, deckId int, version int, userId string) (*DeckDto, error) {
+	current, err := u.deckRepository.FindById(ctx, deckId)
+	if err != nil {
+		return nil, err
+	}
+	if !current.CanEdit(userId) {
+		return nil, domainDeck.ErrNotDeckOwner
+	}
+
+	_, snapshot, err := u.deckVersionRepository.FindByVersion(ctx, deckId, version)
+	if err != nil {
+		return nil, err
+	}
+	// スナップショットには持ち主を保存していないため、今の持ち主を引き継ぐ
+	deck := domainDeck.NewDeckWithoutValidation(deckId, current.GetOwnerId(), snapshot.GetName(), snapshot.GetDescription(), snapshot.GetFormat(), snapshot.GetMainCard(), snapshot.GetSubCard(), snapshot.GetCards())
+
+	// 保存した後にルールやレギュレーションが変わっている場合があるため、更新と同じ検証を行う
+	errs := append(deck.Validate(), deck.ValidateFormat(deck.GetFormat(), u.now())...)
+	if len(errs) > 0 {
+		return nil, &DeckValidationFailedError{
+			Errors:   toViolationDtos(errs),
+			Warnings: toViolationDtos(deck.Warnings()),
+		}
+	}
+
+	if err := u.deckRepository.Update(ctx, deck); err != nil {
+		return nil, err
+	}
+
+	revertedDeck, err := u.deckRepository.FindById(ctx, deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newDeckDto(revertedDeck)
+	dto.Warnings = toViolationDtos(deck.Warnings())
+	return dto, nil
+}
@@ -0,0 +1,96 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// モックデッキバージョンリポジトリ
+type mockDeckVersionRepository struct {
+	mock.Mock
+}
+
+func (m *mockDeckVersionRepository) FindByDeckId(ctx context.Context, deckId int) ([]*domainDeck.DeckVersion, error) {
+	args := m.Called(ctx, deckId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainDeck.DeckVersion), args.Error(1)
+}
+
+func (m *mockDeckVersionRepository) FindByVersion(ctx context.Context, deckId int, version int) (*domainDeck.DeckVersion, *domainDeck.Deck, error) {
+	args := m.Called(ctx, deckId, version)
+	if args.Get(1) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domainDeck.DeckVersion), args.Get(1).(*domainDeck.Deck), args.Error(2)
+}
+
+func TestRevertDeck(t *testing.T) {
+	format, err := domainDeck.FindFormat(domainDeck.FormatStandard)
+	assert.NoError(t, err)
+	cards := []domainDeck.DeckCard{
+		*domainDeck.NewDeckCard(&mockCard{id: 1, name: "ピカチュウ", cardType: 1, regulation: "H", stage: "たね"}, 4),
+		*domainDeck.NewDeckCard(&mockCard{id: 3, name: "基本雷エネルギー", cardType: 3}, 56),
+	}
+	snapshot := domainDeck.NewDeckWithoutValidation(1, "", "前のデッキ", "", format, nil, nil, cards)
+
+	tests := map[string]struct {
+		ownerId     string
+		userId      string
+		expectError error
+		expectCall  bool
+	}{
+		"owner": {
+			ownerId:    "user-1",
+			userId:     "user-1",
+			expectCall: true,
+		},
+		"other user": {
+			ownerId:     "user-1",
+			userId:      "user-2",
+			expectError: domainDeck.ErrNotDeckOwner,
+		},
+		// 持ち主のいないデッキは読み取り専用で、戻した人のものにもならない
+		"unowned deck": {
+			ownerId:     "",
+			userId:      "user-2",
+			expectError: domainDeck.ErrNotDeckOwner,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			current := domainDeck.NewDeckWithoutValidation(1, tt.ownerId, "テストデッキ", "", format, nil, nil, cards)
+			mockDeckRepo := new(mockDeckRepository)
+			mockDeckRepo.On("FindById", mock.Anything, 1).Return(current, nil)
+			mockDeckRepo.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+			mockVersionRepo := new(mockDeckVersionRepository)
+			mockVersionRepo.On("FindByVersion", mock.Anything, 1, 2).Return(&domainDeck.DeckVersion{}, snapshot, nil)
+
+			useCase := NewRevertDeckUseCase(mockDeckRepo, mockVersionRepo)
+			useCase.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+			_, err := useCase.Execute(context.Background(), 1, 2, tt.userId)
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expectCall {
+				mockDeckRepo.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(d *domainDeck.Deck) bool {
+					return d.GetOwnerId() == tt.ownerId && d.GetName() == "前のデッキ"
+				}))
+			} else {
+				mockDeckRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				mockVersionRepo.AssertNotCalled(t, "FindByVersion", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package deck
+
+import (
+	domainDeck "api/domain/deck"
+	"context"
+	"math/rand/v2"
+
+	"github.com/samber/lo"
+)
+
+type ISimulateDeckUseCase interface {
+	Execute(ctx context.Context, id int, request *SimulateDeckRequestDto) (*SimulateDeckResponseDto, error)
+}
+
+type SimulateDeckUseCase struct {
+	deckRepository domainDeck.DeckRepository
+}
+
+func NewSimulateDeckUseCase(deckRepository domainDeck.DeckRepository) *SimulateDeckUseCase {
+	return &SimulateDeckUseCase{
+		deckRepository: deckRepository,
+	}
+}
+
+type SimulateDeckRequestDto struct {
+	Iterations int
+	Turns      int
+	// 省略時はランダムなシードを使い、レスポンスで返したシードを指定すれば再現できる
+	Seed     *uint64
+	Searches []SearchEffectDto
+	Targets  []CardGroupDto
+}
+
+type SearchEffectDto struct {
+	CardName string
+	Targets  []string
+	Count    int
+}
+
+type SimulateDeckResponseDto struct {
+	DeckID           int                 `json:"deck_id"`
+	Iterations       int                 `json:"iterations"`
+	Turns            int                 `json:"turns"`
+	Seed             uint64              `json:"seed"`
+	MulliganRate     float64             `json:"mulligan_rate"`
+	AverageMulligans float64             `json:"average_mulligans"`
+	EvolutionLines   []SimulationRateDto `json:"evolution_lines"`
+	Targets          []SimulationRateDto `json:"targets,omitempty"`
+}
+
+type SimulationRateDto struct {
+	Name string `json:"name"`
+	// 1ターン目から順に並ぶ
+	ByTurn []float64 `json:"by_turn"`
+}
+
+func (u *SimulateDeckUseCase) Execute(ctx context.Context, id int, request *SimulateDeckRequestDto) (*SimulateDeckResponseDto, error) {
+	deck, err := u.deckRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := rand.Uint64()
+	if request.Seed != nil {
+		seed = *request.Seed
+	}
+
+	searches := lo.Map(request.Searches, func(s SearchEffectDto, _ int) domainDeck.SearchEffect {
+		return domainDeck.SearchEffect{
+			CardName: s.CardName,
+			Targets:  s.Targets,
+			Count:    s.Count,
+		}
+	})
+	targets := lo.Map(request.Targets, func(g CardGroupDto, _ int) domainDeck.SimulationTarget {
+		return domainDeck.SimulationTarget{
+			Name:      g.Name,
+			CardNames: g.CardNames,
+		}
+	})
+
+	result, err := deck.Simulate(domainDeck.NewSimulationConfig(request.Iterations, request.Turns, seed, searches, targets))
+	if err != nil {
+		return nil, err
+	}
+
+	toDto := func(r domainDeck.SimulationRate, _ int) SimulationRateDto {
+		return SimulationRateDto{
+			Name:   r.Name,
+			ByTurn: r.ByTurn,
+		}
+	}
+
+	return &SimulateDeckResponseDto{
+		DeckID:           deck.GetId(),
+		Iterations:       result.Iterations,
+		Turns:            result.Turns,
+		Seed:             result.Seed,
+		MulliganRate:     result.MulliganRate,
+		AverageMulligans: result.AverageMulligans,
+		EvolutionLines:   lo.Map(result.EvolutionLines, toDto),
+		Targets:          lo.Map(result.Targets, toDto),
+	}, nil
+}
@@ -1,11 +1,10 @@
 package deck
 
 import (
-	"api/domain"
 	domainDeck "api/domain/deck"
 	"context"
-	"errors"
 	"fmt"
+	"time"
 )
 
 type IUpdateDeckUseCase interface {
@@ -15,12 +14,14 @@ type IUpdateDeckUseCase interface {
 type UpdateDeckUseCase struct {
 	deckRepository domainDeck.DeckRepository
 	cardRepository domainDeck.CardRepository
+	now            func() time.Time
 }
 
 func NewUpdateDeckUseCase(deckRepository domainDeck.DeckRepository, cardRepository domainDeck.CardRepository) *UpdateDeckUseCase {
 	return &UpdateDeckUseCase{
 		deckRepository: deckRepository,
 		cardRepository: cardRepository,
+		now:            time.Now,
 	}
 }
 
@@ -30,68 +31,43 @@ type UpdateDeckRequestDto struct {
 	MainCardID  *CardIDDto           `json:"main_card,omitempty"`
 	SubCardID   *CardIDDto           `json:"sub_card,omitempty"`
 	Cards       []DeckCardRequestDto `json:"cards"`
+	Format      string               `json:"format,omitempty"`
+	// 認証したユーザーのID。リクエストボディからは受け取らない
+	UserID string `json:"-"`
 }
 
 func (u *UpdateDeckUseCase) Execute(ctx context.Context, id int, request *UpdateDeckRequestDto) (*DeckDto, error) {
 	// 既存デッキを取得
-	_, err := u.deckRepository.FindById(ctx, id)
+	existingDeck, err := u.deckRepository.FindById(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("デッキが見つかりません: %w", err)
 	}
-
-	// カード情報の取得
-	var mainCard domain.Card
-	var subCard domain.Card
-	var deckCards []domainDeck.DeckCard
-
-	// メインカードの取得（存在する場合）
-	if request.MainCardID != nil {
-		cardType, exists := domain.StringToCardType[request.MainCardID.Category]
-		if !exists {
-			return nil, errors.New("メインカードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.MainCardID.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-		mainCard = card
+	if !existingDeck.CanEdit(request.UserID) {
+		return nil, domainDeck.ErrNotDeckOwner
 	}
 
-	// サブカードの取得（存在する場合）
-	if request.SubCardID != nil {
-		cardType, exists := domain.StringToCardType[request.SubCardID.Category]
-		if !exists {
-			return nil, errors.New("サブカードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.SubCardID.Id, cardType)
+	// 省略時は登録済みのフォーマットを引き継ぐ
+	format := existingDeck.GetFormat()
+	if request.Format != "" {
+		format, err = domainDeck.FindFormat(request.Format)
 		if err != nil {
 			return nil, err
 		}
-		subCard = card
 	}
 
-	// デッキカードの取得
-	for _, cardRequest := range request.Cards {
-		cardType, exists := domain.StringToCardType[cardRequest.Category]
-		if !exists {
-			return nil, errors.New("カードのカテゴリが不正です")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, cardRequest.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-		deckCard := domainDeck.NewDeckCard(card, cardRequest.Quantity)
-		deckCards = append(deckCards, *deckCard)
+	cards, err := resolveCards(ctx, u.cardRepository, request.MainCardID, request.SubCardID, request.Cards)
+	if err != nil {
+		return nil, err
 	}
 
-	// デッキの作成（既存IDを保持）
-	deck, errs := domainDeck.NewDeck(id, request.Name, request.Description, mainCard, subCard, deckCards)
-	if errs != nil {
-		errorMsg := ""
-		for _, e := range errs {
-			errorMsg += e.Error() + "; "
+	// 検証結果と一緒に警告も返すため、バリデーションなしで組み立ててから検証する
+	deck := domainDeck.NewDeckWithoutValidation(id, request.UserID, request.Name, request.Description, format, cards.mainCard, cards.subCard, cards.deckCards)
+	errs := append(deck.Validate(), deck.ValidateFormat(format, u.now())...)
+	if len(errs) > 0 {
+		return nil, &DeckValidationFailedError{
+			Errors:   toViolationDtos(errs),
+			Warnings: toViolationDtos(deck.Warnings()),
 		}
-		return nil, errors.New(errorMsg)
 	}
 
 	// リポジトリで更新
@@ -144,8 +120,10 @@ func (u *UpdateDeckUseCase) Execute(ctx context.Context, id int, request *Update
 		ID:          updatedDeck.GetId(),
 		Name:        updatedDeck.GetName(),
 		Description: updatedDeck.GetDescription(),
+		Format:      updatedDeck.GetFormat().GetName(),
 		MainCard:    mainCardDto,
 		SubCard:     subCardDto,
 		Cards:       deckCardDtos,
+		Warnings:    toViolationDtos(deck.Warnings()),
 	}, nil
 }
@@ -1,10 +1,9 @@
 package deck
 
 import (
-	"api/domain"
 	domainDeck "api/domain/deck"
 	"context"
-	"errors"
+	"time"
 )
 
 type IValidateDeckUseCase interface {
@@ -13,11 +12,14 @@ type IValidateDeckUseCase interface {
 
 type ValidateDeckUseCase struct {
 	cardRepository domainDeck.CardRepository
+	// レギュレーションの判定に使う現在時刻。テストで差し替える
+	now func() time.Time
 }
 
 func NewValidateDeckUseCase(cardRepository domainDeck.CardRepository) *ValidateDeckUseCase {
 	return &ValidateDeckUseCase{
 		cardRepository: cardRepository,
+		now:            time.Now,
 	}
 }
 
@@ -27,70 +29,56 @@ type ValidateDeckRequestDto struct {
 	MainCardID  *CardIDDto           `json:"main_card,omitempty"`
 	SubCardID   *CardIDDto           `json:"sub_card,omitempty"`
 	Cards       []DeckCardRequestDto `json:"cards"`
+	// 省略時は無制限として扱い、レギュレーションの確認を行わない
+	Format string `json:"format,omitempty"`
+	// 省略時はフォーマットの構築ルールで検証する
+	Ruleset string `json:"ruleset,omitempty"`
 }
 
 type ValidateDeckResponseDto struct {
-	IsValid bool     `json:"is_valid"`
-	Errors  []string `json:"errors,omitempty"`
+	IsValid  bool           `json:"is_valid"`
+	Errors   []ViolationDto `json:"errors,omitempty"`
+	Warnings []ViolationDto `json:"warnings,omitempty"`
 }
 
 func (u *ValidateDeckUseCase) Execute(ctx context.Context, request *ValidateDeckRequestDto) (*ValidateDeckResponseDto, error) {
-	// カード情報の取得
-	var mainCard domain.Card
-	var subCard domain.Card
-	var deckCards []domainDeck.DeckCard
-
-	// メインカードの取得（存在する場合）
-	if request.MainCardID != nil {
-		cardType, exists := domain.StringToCardType[request.MainCardID.Category]
-		if !exists {
-			return nil, errors.New("invalid main card category")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.MainCardID.Id, cardType)
+	var format *domainDeck.Format
+	if request.Format != "" {
+		f, err := domainDeck.FindFormat(request.Format)
 		if err != nil {
 			return nil, err
 		}
-		mainCard = card
+		format = f
 	}
 
-	// サブカードの取得（存在する場合）
-	if request.SubCardID != nil {
-		cardType, exists := domain.StringToCardType[request.SubCardID.Category]
-		if !exists {
-			return nil, errors.New("invalid sub card category")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, request.SubCardID.Id, cardType)
+	// ルールセットを指定した場合はフォーマットの構築ルールより優先する
+	var ruleset *domainDeck.Ruleset
+	if request.Ruleset != "" {
+		r, err := domainDeck.FindRuleset(request.Ruleset)
 		if err != nil {
 			return nil, err
 		}
-		subCard = card
+		ruleset = r
 	}
 
-	// デッキカードの取得
-	for _, cardRequest := range request.Cards {
-		cardType, exists := domain.StringToCardType[cardRequest.Category]
-		if !exists {
-			return nil, errors.New("invalid card category")
-		}
-		card, err := u.cardRepository.FindCardById(ctx, cardRequest.Id, cardType)
-		if err != nil {
-			return nil, err
-		}
-
-		deckCard := domainDeck.NewDeckCard(card, cardRequest.Quantity)
-		deckCards = append(deckCards, *deckCard)
+	cards, err := resolveCards(ctx, u.cardRepository, request.MainCardID, request.SubCardID, request.Cards)
+	if err != nil {
+		return nil, err
 	}
 
-	_, validationErrors := domainDeck.NewDeck(0, request.Name, request.Description, mainCard, subCard, deckCards)
-
-	// エラーメッセージをレスポンス用に変換
-	var errorMessages []string
-	for _, e := range validationErrors {
-		errorMessages = append(errorMessages, e.Error())
+	// 警告も返すためバリデーションなしで組み立ててから検証する
+	deck := domainDeck.NewDeckWithoutValidation(0, "", request.Name, request.Description, format, cards.mainCard, cards.subCard, cards.deckCards)
+	if ruleset == nil {
+		ruleset = deck.GetFormat().GetRuleset()
 	}
 
+	errs, warnings := ruleset.Check(deck)
+	errs = append(errs, deck.ValidateFormat(deck.GetFormat(), u.now())...)
+	violations := toViolationDtos(errs)
+
 	return &ValidateDeckResponseDto{
-		IsValid: len(errorMessages) == 0,
-		Errors:  errorMessages,
+		IsValid:  len(violations) == 0,
+		Errors:   violations,
+		Warnings: toViolationDtos(warnings),
 	}, nil
 }
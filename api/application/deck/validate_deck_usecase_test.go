@@ -0,0 +1,61 @@
+package deck
+
+import (
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidateDeck_Rotation(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	// Gマークは2026年1月23日にスタンダードから外れる
+	rotation := time.Date(2026, 1, 23, 0, 0, 0, 0, jst)
+
+	tests := map[string]struct {
+		now         time.Time
+		expectCodes []string
+	}{
+		"before rotation": {
+			now: rotation.Add(-time.Second),
+		},
+		"at rotation": {
+			now:         rotation,
+			expectCodes: []string{string(domainDeck.CodeIllegalRegulation)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCardRepo := new(mockCardRepository)
+			mockCardRepo.On("FindCardById", mock.Anything, 1, domain.Pokemon).
+				Return(&mockCard{id: 1, name: "ピカチュウ", cardType: 1, regulation: "G", stage: "たね"}, nil)
+			mockCardRepo.On("FindCardById", mock.Anything, 3, domain.Energy).
+				Return(&mockCard{id: 3, name: "基本雷エネルギー", cardType: 3}, nil)
+
+			useCase := NewValidateDeckUseCase(mockCardRepo)
+			useCase.now = func() time.Time { return tt.now }
+
+			result, err := useCase.Execute(context.Background(), &ValidateDeckRequestDto{
+				Name: "テストデッキ",
+				Cards: []DeckCardRequestDto{
+					{Id: 1, Category: "pokemon", Quantity: 4},
+					{Id: 3, Category: "energy", Quantity: 56},
+				},
+				Format: domainDeck.FormatStandard,
+			})
+
+			assert.NoError(t, err)
+			var codes []string
+			for _, v := range result.Errors {
+				codes = append(codes, v.Code)
+			}
+			assert.Equal(t, tt.expectCodes, codes)
+			assert.Equal(t, len(tt.expectCodes) == 0, result.IsValid)
+		})
+	}
+}
@@ -12,6 +12,8 @@ type Pokemon struct {
 	AbilityDescription string
 	Regulation         string
 	Expansion          string
+	Stage              string
+	EvolvesFrom        string
 	Attacks            []PokemonAttack
 }
 
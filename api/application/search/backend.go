@@ -0,0 +1,46 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrSearchUnavailable Meilisearchが使えず、MySQLでも検索できなかった
+var ErrSearchUnavailable = errors.New("search is unavailable")
+
+// 検索結果を返した検索エンジン
+const (
+	BackendMeilisearch = "meilisearch"
+	BackendMySQL       = "mysql"
+)
+
+type backendRecorderKey struct{}
+
+type backendRecorder struct {
+	mu      sync.Mutex
+	backend string
+}
+
+// WithBackendRecorder 検索で使った検索エンジンを記録する。戻り値の関数で記録した検索エンジンを取り出す
+func WithBackendRecorder(ctx context.Context) (context.Context, func() string) {
+	r := &backendRecorder{}
+	return context.WithValue(ctx, backendRecorderKey{}, r), func() string {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.backend
+	}
+}
+
+// RecordBackend 1回の検索で複数の種類を探すので、1つでもMySQLに切り替えていればMySQLとして記録する
+func RecordBackend(ctx context.Context, backend string) {
+	r, ok := ctx.Value(backendRecorderKey{}).(*backendRecorder)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.backend != BackendMySQL {
+		r.backend = backend
+	}
+}
@@ -1,11 +1,15 @@
 package deck
 
-import "context"
+import (
+	"api/application/search/paging"
+	"context"
+)
 
 type SearchDeckListDto struct {
 	Id          int                 `json:"id"`
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
+	Format      string              `json:"format"`
 	MainCard    SearchDeckCardDto   `json:"main_card"`
 	SubCard     SearchDeckCardDto   `json:"sub_card"`
 	Cards       []SearchDeckCardDto `json:"cards"`
@@ -19,6 +23,16 @@ type SearchDeckCardDto struct {
 	ImageURL string `json:"image_url"`
 }
 
+type SearchDeckQuery struct {
+	Q    string
+	Page paging.Page
+}
+
+type SearchDeckResult struct {
+	Decks              []*SearchDeckListDto
+	EstimatedTotalHits int64
+}
+
 type DeckQueryService interface {
-	SearchDeckList(ctx context.Context, q string) ([]*SearchDeckListDto, error)
+	SearchDeckList(ctx context.Context, query SearchDeckQuery) (*SearchDeckResult, error)
 }
@@ -1,15 +1,19 @@
 package deck
 
 import (
+	"api/application/search/paging"
 	"context"
 
 	"github.com/samber/lo"
 )
 
 type ISearchDeckUseCase interface {
-	SearchDeckList(ctx context.Context, q string) ([]*SearchDeckUseCaseDto, error)
+	SearchDeckList(ctx context.Context, req SearchDeckRequestDto) (*SearchDeckPageDto, error)
 }
 
+// デッキはHPを持たないので、HPの高い順は指定できない
+var deckSorts = []paging.SortKey{paging.SortByRelevance, paging.SortByName, paging.SortByNewest}
+
 type SearchDeckUseCase struct {
 	deckQueryService DeckQueryService
 }
@@ -20,10 +24,29 @@ func NewSearchDeckUseCase(deckQueryService DeckQueryService) *SearchDeckUseCase
 	}
 }
 
+// SearchDeckRequestDto pageは1から数える。pageとoffsetはどちらか一方だけ指定する
+type SearchDeckRequestDto struct {
+	Q      string
+	Page   int
+	Offset int
+	Limit  int
+	Sort   string
+}
+
+type SearchDeckPageDto struct {
+	Decks []*SearchDeckUseCaseDto `json:"decks"`
+	// 範囲を指定しなかった場合の件数
+	EstimatedTotalHits int64  `json:"estimated_total_hits"`
+	Offset             int    `json:"offset"`
+	Limit              int    `json:"limit"`
+	Sort               string `json:"sort"`
+}
+
 type SearchDeckUseCaseDto struct {
 	Id          int                        `json:"id"`
 	Name        string                     `json:"name"`
 	Description string                     `json:"description"`
+	Format      string                     `json:"format"`
 	MainCard    SearchDeckCardUseCaseDto   `json:"main_card"`
 	SubCard     SearchDeckCardUseCaseDto   `json:"sub_card"`
 	Cards       []SearchDeckCardUseCaseDto `json:"cards"`
@@ -37,11 +60,12 @@ type SearchDeckCardUseCaseDto struct {
 	ImageURL string `json:"image_url"`
 }
 
-func NewSearchDeckUseCaseDto(id int, name, description string, mainCard, subCard SearchDeckCardUseCaseDto, cards []SearchDeckCardUseCaseDto) *SearchDeckUseCaseDto {
+func NewSearchDeckUseCaseDto(id int, name, description, format string, mainCard, subCard SearchDeckCardUseCaseDto, cards []SearchDeckCardUseCaseDto) *SearchDeckUseCaseDto {
 	return &SearchDeckUseCaseDto{
 		Id:          id,
 		Name:        name,
 		Description: description,
+		Format:      format,
 		MainCard:    mainCard,
 		SubCard:     subCard,
 		Cards:       cards,
@@ -57,13 +81,18 @@ func NewSearchDeckCardUseCaseDto(id int, name, category string, quantity int, im
 	}
 }
 
-func (u *SearchDeckUseCase) SearchDeckList(ctx context.Context, q string) ([]*SearchDeckUseCaseDto, error) {
-	decks, err := u.deckQueryService.SearchDeckList(ctx, q)
+func (u *SearchDeckUseCase) SearchDeckList(ctx context.Context, req SearchDeckRequestDto) (*SearchDeckPageDto, error) {
+	page, err := paging.NewPage(req.Page, req.Offset, req.Limit, req.Sort, deckSorts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := u.deckQueryService.SearchDeckList(ctx, SearchDeckQuery{Q: req.Q, Page: page})
 	if err != nil {
 		return nil, err
 	}
 
-	deckList := lo.Map(decks, func(f *SearchDeckListDto, _ int) *SearchDeckUseCaseDto {
+	deckList := lo.Map(result.Decks, func(f *SearchDeckListDto, _ int) *SearchDeckUseCaseDto {
 		card := lo.Map(f.Cards, func(card SearchDeckCardDto, _ int) SearchDeckCardUseCaseDto {
 			return *NewSearchDeckCardUseCaseDto(card.Id, card.Name, card.Category, card.Quantity, card.ImageURL)
 		})
@@ -72,6 +101,7 @@ func (u *SearchDeckUseCase) SearchDeckList(ctx context.Context, q string) ([]*Se
 			Id:          f.Id,
 			Name:        f.Name,
 			Description: f.Description,
+			Format:      f.Format,
 			MainCard: SearchDeckCardUseCaseDto{
 				Id:       f.MainCard.Id,
 				Name:     f.MainCard.Name,
@@ -88,5 +118,11 @@ func (u *SearchDeckUseCase) SearchDeckList(ctx context.Context, q string) ([]*Se
 		}
 	})
 
-	return deckList, nil
+	return &SearchDeckPageDto{
+		Decks:              deckList,
+		EstimatedTotalHits: result.EstimatedTotalHits,
+		Offset:             page.Offset,
+		Limit:              page.Limit,
+		Sort:               string(page.Sort),
+	}, nil
 }
@@ -0,0 +1,52 @@
+package effect
+
+import (
+	"api/application/search/paging"
+	"api/application/translation"
+	"context"
+)
+
+// Kind ワザか特性か
+type Kind string
+
+const (
+	KindAttack  Kind = "attack"
+	KindAbility Kind = "ability"
+)
+
+// SearchEffectQuery 0・nil・空の条件では絞り込まない
+type SearchEffectQuery struct {
+	Q           string
+	Lang        translation.Lang
+	Kind        Kind
+	MinDamage   int
+	MaxDamage   int
+	MaxEnergy   *int
+	Regulations []string
+	Page        paging.Page
+}
+
+// SearchEffectList 検索に一致したワザか特性と、それを持つポケモン
+type SearchEffectList struct {
+	PokemonID      int
+	PokemonName    string
+	EnergyType     string
+	ImageURL       string
+	Kind           Kind
+	Name           string
+	RequiredEnergy string
+	Damage         string
+	Description    string
+	// 一致した部分を強調し、説明は一致した部分の前後だけに切り詰めたもの
+	HighlightedName        string
+	HighlightedDescription string
+}
+
+type SearchEffectResult struct {
+	Effects            []*SearchEffectList
+	EstimatedTotalHits int64
+}
+
+type EffectQueryService interface {
+	SearchEffectList(ctx context.Context, query SearchEffectQuery) (*SearchEffectResult, error)
+}
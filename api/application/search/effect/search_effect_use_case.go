@@ -0,0 +1,137 @@
+package effect
+
+import (
+	"api/application/search/paging"
+	"api/application/translation"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidSearchParams = errors.New("invalid search params")
+
+// ワザと特性はHPを持たない。キーワードで探すことが多いので、指定がなければ一致度の高い順にする
+var effectSorts = []paging.SortKey{paging.SortByRelevance, paging.SortByName, paging.SortByNewest}
+
+type SearchEffectUseCase struct {
+	effectQueryService EffectQueryService
+}
+
+func NewSearchEffectUseCase(effectQueryService EffectQueryService) *SearchEffectUseCase {
+	return &SearchEffectUseCase{
+		effectQueryService: effectQueryService,
+	}
+}
+
+// SearchEffectRequestDto 「エネルギー2個以下で200ダメージ以上」は MinDamage: 200, MaxEnergy: 2
+type SearchEffectRequestDto struct {
+	Q string
+	// 検索語を入力した言語。En のときは英字をローマ字として読まない
+	Lang        translation.Lang
+	Kind        string
+	MinDamage   int
+	MaxDamage   int
+	MaxEnergy   *int
+	Regulations []string
+	Page        int
+	Offset      int
+	Limit       int
+	Sort        string
+}
+
+type SearchEffectPageDto struct {
+	Effects            []*SearchEffectUseCaseDto `json:"effects"`
+	EstimatedTotalHits int64                     `json:"estimated_total_hits"`
+	Offset             int                       `json:"offset"`
+	Limit              int                       `json:"limit"`
+	Sort               string                    `json:"sort"`
+}
+
+type SearchEffectUseCaseDto struct {
+	PokemonID              int    `json:"pokemon_id"`
+	PokemonName            string `json:"pokemon_name"`
+	EnergyType             string `json:"energy_type"`
+	ImageURL               string `json:"image_url"`
+	Kind                   string `json:"kind"`
+	Name                   string `json:"name"`
+	RequiredEnergy         string `json:"required_energy"`
+	Damage                 string `json:"damage"`
+	Description            string `json:"description"`
+	HighlightedName        string `json:"highlighted_name"`
+	HighlightedDescription string `json:"highlighted_description"`
+}
+
+func (req SearchEffectRequestDto) validate() (SearchEffectQuery, error) {
+	kind := Kind(req.Kind)
+	if kind != "" && kind != KindAttack && kind != KindAbility {
+		return SearchEffectQuery{}, fmt.Errorf("%w: kind は attack か ability で指定してください", ErrInvalidSearchParams)
+	}
+	if req.MinDamage < 0 || req.MaxDamage < 0 || (req.MaxEnergy != nil && *req.MaxEnergy < 0) {
+		return SearchEffectQuery{}, fmt.Errorf("%w: ダメージとエネルギーの数は0以上で指定してください", ErrInvalidSearchParams)
+	}
+	if req.MaxDamage > 0 && req.MinDamage > req.MaxDamage {
+		return SearchEffectQuery{}, fmt.Errorf("%w: damage_min は damage_max 以下で指定してください", ErrInvalidSearchParams)
+	}
+	// 特性にはダメージもエネルギーもないので、ダメージとエネルギーで絞り込むとワザだけになる
+	if kind == KindAbility && (req.MinDamage > 0 || req.MaxDamage > 0 || req.MaxEnergy != nil) {
+		return SearchEffectQuery{}, fmt.Errorf("%w: 特性はダメージとエネルギーの数で絞り込めません", ErrInvalidSearchParams)
+	}
+
+	sort := req.Sort
+	if sort == "" {
+		sort = string(paging.SortByRelevance)
+	}
+	page, err := paging.NewPage(req.Page, req.Offset, req.Limit, sort, effectSorts...)
+	if err != nil {
+		return SearchEffectQuery{}, err
+	}
+
+	return SearchEffectQuery{
+		Q:           req.Q,
+		Lang:        req.Lang,
+		Kind:        kind,
+		MinDamage:   req.MinDamage,
+		MaxDamage:   req.MaxDamage,
+		MaxEnergy:   req.MaxEnergy,
+		Regulations: req.Regulations,
+		Page:        page,
+	}, nil
+}
+
+func (uc *SearchEffectUseCase) SearchEffectList(ctx context.Context, req SearchEffectRequestDto) (*SearchEffectPageDto, error) {
+	query, err := req.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.effectQueryService.SearchEffectList(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	effects := lo.Map(result.Effects, func(f *SearchEffectList, _ int) *SearchEffectUseCaseDto {
+		return &SearchEffectUseCaseDto{
+			PokemonID:              f.PokemonID,
+			PokemonName:            f.PokemonName,
+			EnergyType:             f.EnergyType,
+			ImageURL:               f.ImageURL,
+			Kind:                   string(f.Kind),
+			Name:                   f.Name,
+			RequiredEnergy:         f.RequiredEnergy,
+			Damage:                 f.Damage,
+			Description:            f.Description,
+			HighlightedName:        f.HighlightedName,
+			HighlightedDescription: f.HighlightedDescription,
+		}
+	})
+
+	return &SearchEffectPageDto{
+		Effects:            effects,
+		EstimatedTotalHits: result.EstimatedTotalHits,
+		Offset:             query.Page.Offset,
+		Limit:              query.Page.Limit,
+		Sort:               string(query.Page.Sort),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package effect
+
+import (
+	"api/application/search/paging"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubEffectQueryService struct {
+	queries []SearchEffectQuery
+}
+
+func (s *stubEffectQueryService) SearchEffectList(_ context.Context, query SearchEffectQuery) (*SearchEffectResult, error) {
+	s.queries = append(s.queries, query)
+	return &SearchEffectResult{
+		Effects: []*SearchEffectList{{
+			PokemonID:              1,
+			PokemonName:            "ドラパルトex",
+			Kind:                   KindAttack,
+			Name:                   "ファントムダイブ",
+			Damage:                 "200",
+			HighlightedDescription: "…<em>ダメカン</em>を6個…",
+		}},
+		EstimatedTotalHits: 1,
+	}, nil
+}
+
+func TestSearchEffectList_DamageAndEnergy(t *testing.T) {
+	s := &stubEffectQueryService{}
+	uc := NewSearchEffectUseCase(s)
+	maxEnergy := 2
+
+	dto, err := uc.SearchEffectList(context.Background(), SearchEffectRequestDto{
+		Q:         "ダメカン",
+		MinDamage: 200,
+		MaxEnergy: &maxEnergy,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s.queries[0].MinDamage)
+	assert.Equal(t, &maxEnergy, s.queries[0].MaxEnergy)
+	assert.Equal(t, paging.SortByRelevance, s.queries[0].Page.Sort)
+	assert.Equal(t, "attack", dto.Effects[0].Kind)
+	assert.Equal(t, "…<em>ダメカン</em>を6個…", dto.Effects[0].HighlightedDescription)
+	assert.Equal(t, "relevance", dto.Sort)
+}
+
+func TestSearchEffectList_InvalidParams(t *testing.T) {
+	zero := 0
+	negative := -1
+	cases := map[string]SearchEffectRequestDto{
+		"unknown kind":        {Kind: "item"},
+		"negative damage":     {MinDamage: -10},
+		"negative energy":     {MaxEnergy: &negative},
+		"min over max":        {MinDamage: 200, MaxDamage: 100},
+		"ability with energy": {Kind: "ability", MaxEnergy: &zero},
+		"ability with damage": {Kind: "ability", MinDamage: 10},
+	}
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &stubEffectQueryService{}
+			_, err := NewSearchEffectUseCase(s).SearchEffectList(context.Background(), req)
+			assert.True(t, errors.Is(err, ErrInvalidSearchParams))
+			assert.Empty(t, s.queries)
+		})
+	}
+}
+
+func TestSearchEffectList_InvalidPage(t *testing.T) {
+	s := &stubEffectQueryService{}
+	_, err := NewSearchEffectUseCase(s).SearchEffectList(context.Background(), SearchEffectRequestDto{Sort: "hp"})
+	assert.True(t, errors.Is(err, paging.ErrInvalidPage))
+}
@@ -1,6 +1,11 @@
 package energy
 
-import "context"
+import (
+	"api/application/search/facet"
+	"api/application/search/paging"
+	"api/application/translation"
+	"context"
+)
 
 type SearchEnergyList struct {
 	ID          int    `json:"id"`
@@ -9,6 +14,22 @@ type SearchEnergyList struct {
 	Description string `json:"description"`
 }
 
+// SearchEnergyQuery 空のスライス・nilの条件では絞り込まない
+type SearchEnergyQuery struct {
+	Q           string
+	Lang        translation.Lang
+	Regulations []string
+	Expansions  []string
+	AceSpec     *bool
+	Page        paging.Page
+}
+
+type SearchEnergyResult struct {
+	Energies           []*SearchEnergyList
+	Facets             facet.Facets
+	EstimatedTotalHits int64
+}
+
 type EnergyQueryService interface {
-	SearchEnergyList(ctx context.Context, q string) ([]*SearchEnergyList, error)
+	SearchEnergyList(ctx context.Context, query SearchEnergyQuery) (*SearchEnergyResult, error)
 }
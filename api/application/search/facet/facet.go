@@ -0,0 +1,24 @@
+// Package facet は検索条件ごとの件数を表す
+package facet
+
+// Range 数値の属性が取る値の範囲
+type Range struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// Facets 検索にヒットしたカードを属性の値ごとに数えたもの
+type Facets struct {
+	// 属性ごとの、値ごとの件数
+	Distribution map[string]map[string]int64 `json:"distribution"`
+	// 数値の属性ごとの、値の範囲
+	Stats map[string]Range `json:"stats"`
+}
+
+// Empty 検索しなかった種類や、件数を数えられない検索エンジンで使う
+func Empty() Facets {
+	return Facets{
+		Distribution: map[string]map[string]int64{},
+		Stats:        map[string]Range{},
+	}
+}
@@ -0,0 +1,90 @@
+// Package paging は検索結果の並び順と取得する範囲を表す
+package paging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidPage = errors.New("invalid page")
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 100
+	// MaxOffset Meilisearchのインデックスに設定したmaxTotalHitsより先は取得できない
+	MaxOffset = 20000
+)
+
+type SortKey string
+
+const (
+	// SortByRelevance キーワードに近い順
+	SortByRelevance SortKey = "relevance"
+	// SortByName 名前の昇順
+	SortByName SortKey = "name"
+	// SortByHp HPの高い順。HPを持たないカードは SortByNewest と同じ順になる
+	SortByHp SortKey = "hp"
+	// SortByNewest 新しく登録された順
+	SortByNewest SortKey = "newest"
+)
+
+type Page struct {
+	Offset int
+	Limit  int
+	Sort   SortKey
+}
+
+// Default 条件を指定しなかったときの範囲と並び順
+func Default() Page {
+	return Page{Offset: 0, Limit: DefaultLimit, Sort: SortByNewest}
+}
+
+// NewPage pageは1から数える。page・offset・limitは0、sortは空文字列なら指定なしとして扱う
+// pageとoffsetは同時に指定できない。sortはsortsのどれか
+func NewPage(page, offset, limit int, sort string, sorts ...SortKey) (Page, error) {
+	if page < 0 || offset < 0 || limit < 0 {
+		return Page{}, fmt.Errorf("%w: page, offset, limit は0以上で指定してください", ErrInvalidPage)
+	}
+	if page > 0 && offset > 0 {
+		return Page{}, fmt.Errorf("%w: page と offset は同時に指定できません", ErrInvalidPage)
+	}
+	if limit == 0 {
+		limit = Default().Limit
+	}
+	if limit > MaxLimit {
+		return Page{}, fmt.Errorf("%w: limit は%d以下で指定してください", ErrInvalidPage, MaxLimit)
+	}
+	if page > 0 {
+		if page-1 > MaxOffset/limit {
+			return Page{}, fmt.Errorf("%w: offset は%d以下になるように指定してください", ErrInvalidPage, MaxOffset)
+		}
+		offset = (page - 1) * limit
+	}
+	if offset > MaxOffset {
+		return Page{}, fmt.Errorf("%w: offset は%d以下になるように指定してください", ErrInvalidPage, MaxOffset)
+	}
+
+	key := Default().Sort
+	if sort != "" {
+		key = SortKey(sort)
+		if !contains(sorts, key) {
+			names := make([]string, len(sorts))
+			for i, s := range sorts {
+				names[i] = string(s)
+			}
+			return Page{}, fmt.Errorf("%w: sort は %s のいずれかを指定してください", ErrInvalidPage, strings.Join(names, ", "))
+		}
+	}
+
+	return Page{Offset: offset, Limit: limit, Sort: key}, nil
+}
+
+func contains(sorts []SortKey, key SortKey) bool {
+	for _, s := range sorts {
+		if s == key {
+			return true
+		}
+	}
+	return false
+}
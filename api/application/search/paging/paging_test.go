@@ -0,0 +1,54 @@
+package paging
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPage(t *testing.T) {
+	cardSorts := []SortKey{SortByRelevance, SortByName, SortByHp, SortByNewest}
+
+	tests := []struct {
+		name    string
+		page    int
+		offset  int
+		limit   int
+		sort    string
+		want    Page
+		wantErr bool
+	}{
+		{name: "defaults", want: Page{Offset: 0, Limit: DefaultLimit, Sort: SortByNewest}},
+		{name: "page", page: 3, limit: 20, sort: "hp", want: Page{Offset: 40, Limit: 20, Sort: SortByHp}},
+		{name: "offset", offset: 15, sort: "relevance", want: Page{Offset: 15, Limit: DefaultLimit, Sort: SortByRelevance}},
+		{name: "max limit", limit: MaxLimit, want: Page{Limit: MaxLimit, Sort: SortByNewest}},
+		{name: "limit over max", limit: MaxLimit + 1, wantErr: true},
+		{name: "page and offset", page: 2, offset: 10, wantErr: true},
+		{name: "negative offset", offset: -1, wantErr: true},
+		{name: "max offset", offset: MaxOffset, want: Page{Offset: MaxOffset, Limit: DefaultLimit, Sort: SortByNewest}},
+		{name: "offset over max", offset: MaxOffset + 1, wantErr: true},
+		{name: "last page", page: MaxOffset/MaxLimit + 1, limit: MaxLimit, want: Page{Offset: MaxOffset, Limit: MaxLimit, Sort: SortByNewest}},
+		{name: "page over max offset", page: MaxOffset/MaxLimit + 2, limit: MaxLimit, wantErr: true},
+		{name: "page overflows", page: math.MaxInt, limit: MaxLimit, wantErr: true},
+		{name: "unknown sort", sort: "price", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPage(tt.page, tt.offset, tt.limit, tt.sort, cardSorts...)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPage)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPage_SortNotAllowed(t *testing.T) {
+	_, err := NewPage(0, 0, 0, "hp", SortByRelevance, SortByName, SortByNewest)
+
+	assert.ErrorIs(t, err, ErrInvalidPage)
+}
@@ -1,14 +1,21 @@
 package pokemon
 
-import "context"
+import (
+	"api/application/search/facet"
+	"api/application/search/paging"
+	"api/application/translation"
+	"context"
+)
 
 type SearchPokemonList struct {
-	ID         int                   `json:"id"`
-	Name       string                `json:"name"`
-	EnergyType string                `json:"energy_type"`
-	Hp         int                   `json:"hp"`
-	ImageURL   string                `json:"image_url"`
-	Attacks    []PokemonAttackResult `json:"attacks"`
+	ID          int                   `json:"id"`
+	Name        string                `json:"name"`
+	EnergyType  string                `json:"energy_type"`
+	Hp          int                   `json:"hp"`
+	ImageURL    string                `json:"image_url"`
+	Stage       string                `json:"stage"`
+	EvolvesFrom string                `json:"evolves_from"`
+	Attacks     []PokemonAttackResult `json:"attacks"`
 }
 
 type PokemonAttackResult struct {
@@ -18,6 +25,27 @@ type PokemonAttackResult struct {
 	Description    string `json:"description"`
 }
 
+// SearchPokemonQuery 空のスライス・0・nilの条件では絞り込まない
+type SearchPokemonQuery struct {
+	Q           string
+	Lang        translation.Lang
+	EnergyTypes []string
+	MinHp       int
+	MaxHp       int
+	Regulations []string
+	Expansions  []string
+	AceSpec     *bool
+	HasAbility  *bool
+	Page        paging.Page
+}
+
+type SearchPokemonResult struct {
+	Pokemons []*SearchPokemonList
+	Facets   facet.Facets
+	// Meilisearchは正確な件数を数えないので推定値
+	EstimatedTotalHits int64
+}
+
 type PokemonQueryService interface {
-	SearchPokemonList(ctx context.Context, q string) ([]*SearchPokemonList, error)
+	SearchPokemonList(ctx context.Context, query SearchPokemonQuery) (*SearchPokemonResult, error)
 }
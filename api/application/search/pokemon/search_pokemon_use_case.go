@@ -1,6 +1,7 @@
 package pokemon
 
 import (
+	"api/application/search/paging"
 	"context"
 	"fmt"
 
@@ -12,12 +13,14 @@ type SearchPokemonUseCase struct {
 }
 
 type SearchPokemonUseCaseDto struct {
-	ID         string
-	Name       string
-	EnergyType string
-	Hp         int
-	ImageURL   string
-	Attacks    []*AttackDto
+	ID          string
+	Name        string
+	EnergyType  string
+	Hp          int
+	ImageURL    string
+	Stage       string
+	EvolvesFrom string
+	Attacks     []*AttackDto
 }
 
 type AttackDto struct {
@@ -28,12 +31,12 @@ type AttackDto struct {
 }
 
 func (uc *SearchPokemonUseCase) SearchPokemonList(ctx context.Context, q string) ([]*SearchPokemonUseCaseDto, error) {
-	searchPokemonLists, err := uc.pokemonQueryService.SearchPokemonList(ctx, q)
+	result, err := uc.pokemonQueryService.SearchPokemonList(ctx, SearchPokemonQuery{Q: q, Page: paging.Default()})
 	if err != nil {
 		return nil, err
 	}
 
-	dtoList := lo.Map(searchPokemonLists, func(f *SearchPokemonList, _ int) *SearchPokemonUseCaseDto {
+	dtoList := lo.Map(result.Pokemons, func(f *SearchPokemonList, _ int) *SearchPokemonUseCaseDto {
 		attacks := lo.Map(f.Attacks, func(attack PokemonAttackResult, _ int) *AttackDto {
 			return &AttackDto{
 				Name:           attack.Name,
@@ -44,12 +47,14 @@ func (uc *SearchPokemonUseCase) SearchPokemonList(ctx context.Context, q string)
 		})
 
 		return &SearchPokemonUseCaseDto{
-			ID:         fmt.Sprintf("%v", f.ID),
-			Name:       f.Name,
-			EnergyType: f.EnergyType,
-			Hp:         f.Hp,
-			ImageURL:   f.ImageURL,
-			Attacks:    attacks,
+			ID:          fmt.Sprintf("%v", f.ID),
+			Name:        f.Name,
+			EnergyType:  f.EnergyType,
+			Hp:          f.Hp,
+			ImageURL:    f.ImageURL,
+			Stage:       f.Stage,
+			EvolvesFrom: f.EvolvesFrom,
+			Attacks:     attacks,
 		}
 	})
 
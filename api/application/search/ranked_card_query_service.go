@@ -0,0 +1,40 @@
+package search
+
+import (
+	"api/application/search/energy"
+	"api/application/search/paging"
+	"api/application/search/pokemon"
+	"api/application/search/trainer"
+	"context"
+)
+
+// SearchRankedCardQuery nilの種類は検索しない。種類ごとのPageは使わず、Pageで並べた後の範囲を指定する
+type SearchRankedCardQuery struct {
+	Pokemon *pokemon.SearchPokemonQuery
+	Trainer *trainer.SearchTrainerQuery
+	Energy  *energy.SearchEnergyQuery
+	Page    paging.Page
+}
+
+// RankedCard 種類をまたいでスコアの高い順に並べたカード。種類ごとに持たない項目は空のまま
+type RankedCard struct {
+	CardType    string
+	ID          int
+	Name        string
+	ImageURL    string
+	EnergyType  string
+	Hp          int
+	TrainerType string
+	Description string
+	// 0から1。キーワードに近いほど大きい
+	Score float64
+}
+
+type SearchRankedCardResult struct {
+	Cards              []*RankedCard
+	EstimatedTotalHits int64
+}
+
+type RankedCardQueryService interface {
+	SearchRankedCardList(ctx context.Context, query SearchRankedCardQuery) (*SearchRankedCardResult, error)
+}
@@ -2,14 +2,20 @@ package search
 
 import (
 	"api/application/search/energy"
+	"api/application/search/facet"
+	"api/application/search/paging"
 	"api/application/search/pokemon"
 	"api/application/search/trainer"
+	"api/application/translation"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/samber/lo"
 )
 
+var ErrInvalidSearchParams = errors.New("invalid search params")
+
 type SearchPokemonAndTrainerUseCase struct {
 	pokemonQueryService pokemon.PokemonQueryService
 	trainerQueryService trainer.TrainerQueryService
@@ -28,97 +34,245 @@ func NewSearchPokemonAndTrainerUseCase(
 	}
 }
 
+// SearchCardRequestDto 空のスライス・0・nilの条件では絞り込まない
+// タイプ・HP・特性はポケモンだけ、トレーナーの種類はトレーナーだけが持つので、指定するとそれ以外の種類はヒットしない
+type SearchCardRequestDto struct {
+	Q string
+	// 検索語を入力した言語。En のときは英字をローマ字として読まない
+	Lang         translation.Lang
+	EnergyTypes  []string
+	MinHp        int
+	MaxHp        int
+	Regulations  []string
+	Expansions   []string
+	TrainerTypes []string
+	AceSpec      *bool
+	HasAbility   *bool
+	// 種類ごとに同じ範囲を返す。pageは1から数える
+	Page   int
+	Offset int
+	Limit  int
+	Sort   string
+}
+
+// 検索結果に指定できる並び順
+var cardSorts = []paging.SortKey{paging.SortByRelevance, paging.SortByName, paging.SortByHp, paging.SortByNewest}
+
+func (r SearchCardRequestDto) hasPokemonFilter() bool {
+	return len(r.EnergyTypes) > 0 || r.MinHp > 0 || r.MaxHp > 0 || r.HasAbility != nil
+}
+
+func (r SearchCardRequestDto) hasTrainerFilter() bool {
+	return len(r.TrainerTypes) > 0
+}
+
+// validate 取得する範囲と並び順を返す
+func (r SearchCardRequestDto) validate() (paging.Page, error) {
+	if err := r.validateHp(); err != nil {
+		return paging.Page{}, err
+	}
+	return paging.NewPage(r.Page, r.Offset, r.Limit, r.Sort, cardSorts...)
+}
+
+func (r SearchCardRequestDto) validateHp() error {
+	if r.MinHp < 0 || r.MaxHp < 0 {
+		return fmt.Errorf("%w: hp_min と hp_max は0以上で指定してください", ErrInvalidSearchParams)
+	}
+	if r.MaxHp > 0 && r.MinHp > r.MaxHp {
+		return fmt.Errorf("%w: hp_min は hp_max 以下で指定してください", ErrInvalidSearchParams)
+	}
+	return nil
+}
+
+func (r SearchCardRequestDto) pokemonQuery(page paging.Page) pokemon.SearchPokemonQuery {
+	return pokemon.SearchPokemonQuery{
+		Q:           r.Q,
+		Lang:        r.Lang,
+		EnergyTypes: r.EnergyTypes,
+		MinHp:       r.MinHp,
+		MaxHp:       r.MaxHp,
+		Regulations: r.Regulations,
+		Expansions:  r.Expansions,
+		AceSpec:     r.AceSpec,
+		HasAbility:  r.HasAbility,
+		Page:        page,
+	}
+}
+
+func (r SearchCardRequestDto) trainerQuery(page paging.Page) trainer.SearchTrainerQuery {
+	return trainer.SearchTrainerQuery{
+		Q:            r.Q,
+		Lang:         r.Lang,
+		TrainerTypes: r.TrainerTypes,
+		Regulations:  r.Regulations,
+		Expansions:   r.Expansions,
+		AceSpec:      r.AceSpec,
+		Page:         page,
+	}
+}
+
+func (r SearchCardRequestDto) energyQuery(page paging.Page) energy.SearchEnergyQuery {
+	return energy.SearchEnergyQuery{
+		Q:           r.Q,
+		Lang:        r.Lang,
+		Regulations: r.Regulations,
+		Expansions:  r.Expansions,
+		AceSpec:     r.AceSpec,
+		Page:        page,
+	}
+}
+
 type SearchPokemonAndTrainerUseCaseDto struct {
 	Pokemons []*pokemon.SearchPokemonUseCaseDto `json:"pokemons"`
 	Trainers []*trainer.SearchTrainerUseCaseDto `json:"trainers"`
 	Energies []*energy.SearchEnergyUseCaseDto   `json:"energies"`
+	Facets   SearchCardFacetsDto                `json:"facets"`
+	// 種類ごとの、範囲を指定しなかった場合の件数
+	EstimatedTotalHits SearchCardCountsDto `json:"estimated_total_hits"`
+	Offset             int                 `json:"offset"`
+	Limit              int                 `json:"limit"`
+	Sort               string              `json:"sort"`
+	// 検索結果を返した検索エンジン。Meilisearchが使えないときはMySQL
+	Backend string `json:"backend"`
+}
+
+type SearchCardCountsDto struct {
+	Pokemons int64 `json:"pokemons"`
+	Trainers int64 `json:"trainers"`
+	Energies int64 `json:"energies"`
+}
+
+// SearchCardFacetsDto カードの種類ごとの、絞り込み条件の値ごとの件数
+type SearchCardFacetsDto struct {
+	Pokemons facet.Facets `json:"pokemons"`
+	Trainers facet.Facets `json:"trainers"`
+	Energies facet.Facets `json:"energies"`
 }
 
-func (uc *SearchPokemonAndTrainerUseCase) SearchPokemonAndTrainerList(ctx context.Context, q string) (*SearchPokemonAndTrainerUseCaseDto, error) {
-	searchPokemonList, err := uc.pokemonQueryService.SearchPokemonList(ctx, q)
+func (uc *SearchPokemonAndTrainerUseCase) SearchPokemonAndTrainerList(ctx context.Context, req SearchCardRequestDto) (*SearchPokemonAndTrainerUseCaseDto, error) {
+	page, err := req.validate()
 	if err != nil {
 		return nil, err
 	}
+	ctx, backend := WithBackendRecorder(ctx)
 
-	searchTrainerList, err := uc.trainerQueryService.SearchTrainerList(ctx, q)
-	if err != nil {
+	dto := newSearchCardDto(page)
+	if err := uc.searchPokemons(ctx, req, page, dto); err != nil {
 		return nil, err
 	}
+	if err := uc.searchTrainers(ctx, req, page, dto); err != nil {
+		return nil, err
+	}
+	if err := uc.searchEnergies(ctx, req, page, dto); err != nil {
+		return nil, err
+	}
+	dto.Backend = backend()
+
+	return dto, nil
+}
 
-	searchEnergyList, err := uc.energyQueryService.SearchEnergyList(ctx, q)
+func (uc *SearchPokemonAndTrainerUseCase) SearchPokemonList(ctx context.Context, req SearchCardRequestDto) (*SearchPokemonAndTrainerUseCaseDto, error) {
+	page, err := req.validate()
 	if err != nil {
 		return nil, err
 	}
+	ctx, backend := WithBackendRecorder(ctx)
 
-	pokemons := lo.Map(searchPokemonList, func(f *pokemon.SearchPokemonList, _ int) *pokemon.SearchPokemonUseCaseDto {
-		return &pokemon.SearchPokemonUseCaseDto{
-			ID:         fmt.Sprintf("%v", f.ID),
-			Name:       f.Name,
-			EnergyType: f.EnergyType,
-			Hp:         f.Hp,
-			ImageURL:   f.ImageURL,
-		}
-	})
+	dto := newSearchCardDto(page)
+	if err := uc.searchPokemons(ctx, req, page, dto); err != nil {
+		return nil, err
+	}
+	dto.Backend = backend()
 
-	trainers := lo.Map(searchTrainerList, func(f *trainer.SearchTrainerList, _ int) *trainer.SearchTrainerUseCaseDto {
-		return &trainer.SearchTrainerUseCaseDto{
-			ID:          fmt.Sprintf("%v", f.ID),
-			Name:        f.Name,
-			TrainerType: f.TrainerType,
-			ImageURL:    f.ImageURL,
-		}
-	})
+	return dto, nil
+}
 
-	energies := lo.Map(searchEnergyList, func(f *energy.SearchEnergyList, _ int) *energy.SearchEnergyUseCaseDto {
-		return &energy.SearchEnergyUseCaseDto{
-			ID:          fmt.Sprintf("%v", f.ID),
-			Name:        f.Name,
-			ImageURL:    f.ImageURL,
-			Description: f.Description,
-		}
-	})
+func (uc *SearchPokemonAndTrainerUseCase) SearchTrainerList(ctx context.Context, req SearchCardRequestDto) (*SearchPokemonAndTrainerUseCaseDto, error) {
+	page, err := req.validate()
+	if err != nil {
+		return nil, err
+	}
+	ctx, backend := WithBackendRecorder(ctx)
 
-	dto := &SearchPokemonAndTrainerUseCaseDto{
-		Pokemons: pokemons,
-		Trainers: trainers,
-		Energies: energies,
+	dto := newSearchCardDto(page)
+	if err := uc.searchTrainers(ctx, req, page, dto); err != nil {
+		return nil, err
 	}
+	dto.Backend = backend()
 
 	return dto, nil
 }
 
-func (uc *SearchPokemonAndTrainerUseCase) SearchPokemonList(ctx context.Context, q string) (*SearchPokemonAndTrainerUseCaseDto, error) {
-	searchPokemonList, err := uc.pokemonQueryService.SearchPokemonList(ctx, q)
+func (uc *SearchPokemonAndTrainerUseCase) SearchEnergyList(ctx context.Context, req SearchCardRequestDto) (*SearchPokemonAndTrainerUseCaseDto, error) {
+	page, err := req.validate()
 	if err != nil {
 		return nil, err
 	}
+	ctx, backend := WithBackendRecorder(ctx)
 
-	pokemons := lo.Map(searchPokemonList, func(f *pokemon.SearchPokemonList, _ int) *pokemon.SearchPokemonUseCaseDto {
-		return &pokemon.SearchPokemonUseCaseDto{
-			ID:         fmt.Sprintf("%v", f.ID),
-			Name:       f.Name,
-			EnergyType: f.EnergyType,
-			Hp:         f.Hp,
-			ImageURL:   f.ImageURL,
-		}
-	})
+	dto := newSearchCardDto(page)
+	if err := uc.searchEnergies(ctx, req, page, dto); err != nil {
+		return nil, err
+	}
+	dto.Backend = backend()
+
+	return dto, nil
+}
 
-	dto := &SearchPokemonAndTrainerUseCaseDto{
-		Pokemons: pokemons,
+// newSearchCardDto 検索しなかった種類は空のまま返す
+func newSearchCardDto(page paging.Page) *SearchPokemonAndTrainerUseCaseDto {
+	return &SearchPokemonAndTrainerUseCaseDto{
+		Pokemons: make([]*pokemon.SearchPokemonUseCaseDto, 0),
 		Trainers: make([]*trainer.SearchTrainerUseCaseDto, 0),
 		Energies: make([]*energy.SearchEnergyUseCaseDto, 0),
+		Facets: SearchCardFacetsDto{
+			Pokemons: facet.Empty(),
+			Trainers: facet.Empty(),
+			Energies: facet.Empty(),
+		},
+		Offset: page.Offset,
+		Limit:  page.Limit,
+		Sort:   string(page.Sort),
 	}
+}
 
-	return dto, nil
+func (uc *SearchPokemonAndTrainerUseCase) searchPokemons(ctx context.Context, req SearchCardRequestDto, page paging.Page, dto *SearchPokemonAndTrainerUseCaseDto) error {
+	if req.hasTrainerFilter() {
+		return nil
+	}
+
+	result, err := uc.pokemonQueryService.SearchPokemonList(ctx, req.pokemonQuery(page))
+	if err != nil {
+		return err
+	}
+
+	dto.Pokemons = lo.Map(result.Pokemons, func(f *pokemon.SearchPokemonList, _ int) *pokemon.SearchPokemonUseCaseDto {
+		return &pokemon.SearchPokemonUseCaseDto{
+			ID:          fmt.Sprintf("%v", f.ID),
+			Name:        f.Name,
+			EnergyType:  f.EnergyType,
+			Hp:          f.Hp,
+			ImageURL:    f.ImageURL,
+			Stage:       f.Stage,
+			EvolvesFrom: f.EvolvesFrom,
+		}
+	})
+	dto.Facets.Pokemons = result.Facets
+	dto.EstimatedTotalHits.Pokemons = result.EstimatedTotalHits
+	return nil
 }
 
-func (uc *SearchPokemonAndTrainerUseCase) SearchTrainerList(ctx context.Context, q string) (*SearchPokemonAndTrainerUseCaseDto, error) {
-	searchTrainerList, err := uc.trainerQueryService.SearchTrainerList(ctx, q)
+func (uc *SearchPokemonAndTrainerUseCase) searchTrainers(ctx context.Context, req SearchCardRequestDto, page paging.Page, dto *SearchPokemonAndTrainerUseCaseDto) error {
+	if req.hasPokemonFilter() {
+		return nil
+	}
+
+	result, err := uc.trainerQueryService.SearchTrainerList(ctx, req.trainerQuery(page))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	trainers := lo.Map(searchTrainerList, func(f *trainer.SearchTrainerList, _ int) *trainer.SearchTrainerUseCaseDto {
+	dto.Trainers = lo.Map(result.Trainers, func(f *trainer.SearchTrainerList, _ int) *trainer.SearchTrainerUseCaseDto {
 		return &trainer.SearchTrainerUseCaseDto{
 			ID:          fmt.Sprintf("%v", f.ID),
 			Name:        f.Name,
@@ -126,23 +280,22 @@ func (uc *SearchPokemonAndTrainerUseCase) SearchTrainerList(ctx context.Context,
 			ImageURL:    f.ImageURL,
 		}
 	})
+	dto.Facets.Trainers = result.Facets
+	dto.EstimatedTotalHits.Trainers = result.EstimatedTotalHits
+	return nil
+}
 
-	dto := &SearchPokemonAndTrainerUseCaseDto{
-		Pokemons: make([]*pokemon.SearchPokemonUseCaseDto, 0),
-		Trainers: trainers,
-		Energies: make([]*energy.SearchEnergyUseCaseDto, 0),
+func (uc *SearchPokemonAndTrainerUseCase) searchEnergies(ctx context.Context, req SearchCardRequestDto, page paging.Page, dto *SearchPokemonAndTrainerUseCaseDto) error {
+	if req.hasPokemonFilter() || req.hasTrainerFilter() {
+		return nil
 	}
 
-	return dto, nil
-}
-
-func (uc *SearchPokemonAndTrainerUseCase) SearchEnergyList(ctx context.Context, q string) (*SearchPokemonAndTrainerUseCaseDto, error) {
-	searchEnergyList, err := uc.energyQueryService.SearchEnergyList(ctx, q)
+	result, err := uc.energyQueryService.SearchEnergyList(ctx, req.energyQuery(page))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	energies := lo.Map(searchEnergyList, func(f *energy.SearchEnergyList, _ int) *energy.SearchEnergyUseCaseDto {
+	dto.Energies = lo.Map(result.Energies, func(f *energy.SearchEnergyList, _ int) *energy.SearchEnergyUseCaseDto {
 		return &energy.SearchEnergyUseCaseDto{
 			ID:          fmt.Sprintf("%v", f.ID),
 			Name:        f.Name,
@@ -150,12 +303,7 @@ func (uc *SearchPokemonAndTrainerUseCase) SearchEnergyList(ctx context.Context,
 			Description: f.Description,
 		}
 	})
-
-	dto := &SearchPokemonAndTrainerUseCaseDto{
-		Pokemons: make([]*pokemon.SearchPokemonUseCaseDto, 0),
-		Trainers: make([]*trainer.SearchTrainerUseCaseDto, 0),
-		Energies: energies,
-	}
-
-	return dto, nil
+	dto.Facets.Energies = result.Facets
+	dto.EstimatedTotalHits.Energies = result.EstimatedTotalHits
+	return nil
 }
@@ -0,0 +1,152 @@
+package search
+
+import (
+	"api/application/search/energy"
+	"api/application/search/facet"
+	"api/application/search/paging"
+	"api/application/search/pokemon"
+	"api/application/search/trainer"
+	"api/application/translation"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubPokemonQueryService struct {
+	queries []pokemon.SearchPokemonQuery
+}
+
+func (s *stubPokemonQueryService) SearchPokemonList(_ context.Context, query pokemon.SearchPokemonQuery) (*pokemon.SearchPokemonResult, error) {
+	s.queries = append(s.queries, query)
+	return &pokemon.SearchPokemonResult{
+		Pokemons: []*pokemon.SearchPokemonList{{ID: 1, Name: "ゲッコウガex"}},
+		Facets: facet.Facets{
+			Distribution: map[string]map[string]int64{"energy_type": {"水": 1}},
+			Stats:        map[string]facet.Range{"hp": {Min: 310, Max: 310}},
+		},
+		EstimatedTotalHits: 42,
+	}, nil
+}
+
+type stubTrainerQueryService struct {
+	queries []trainer.SearchTrainerQuery
+}
+
+func (s *stubTrainerQueryService) SearchTrainerList(_ context.Context, query trainer.SearchTrainerQuery) (*trainer.SearchTrainerResult, error) {
+	s.queries = append(s.queries, query)
+	return &trainer.SearchTrainerResult{Trainers: []*trainer.SearchTrainerList{{ID: 2}}, Facets: facet.Empty()}, nil
+}
+
+type stubEnergyQueryService struct {
+	queries []energy.SearchEnergyQuery
+}
+
+func (s *stubEnergyQueryService) SearchEnergyList(_ context.Context, query energy.SearchEnergyQuery) (*energy.SearchEnergyResult, error) {
+	s.queries = append(s.queries, query)
+	return &energy.SearchEnergyResult{Energies: []*energy.SearchEnergyList{{ID: 3}}, Facets: facet.Empty()}, nil
+}
+
+func newStubUseCase() (*SearchPokemonAndTrainerUseCase, *stubPokemonQueryService, *stubTrainerQueryService, *stubEnergyQueryService) {
+	p, t, e := &stubPokemonQueryService{}, &stubTrainerQueryService{}, &stubEnergyQueryService{}
+	return NewSearchPokemonAndTrainerUseCase(p, t, e), p, t, e
+}
+
+func TestSearchPokemonAndTrainerList_CommonFilters(t *testing.T) {
+	uc, p, tr, e := newStubUseCase()
+	aceSpec := true
+
+	dto, err := uc.SearchPokemonAndTrainerList(context.Background(), SearchCardRequestDto{
+		Q:           "マスター",
+		Lang:        translation.En,
+		Regulations: []string{"H"},
+		AceSpec:     &aceSpec,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, dto.Pokemons, 1)
+	assert.Len(t, dto.Trainers, 1)
+	assert.Len(t, dto.Energies, 1)
+	assert.Equal(t, []string{"H"}, p.queries[0].Regulations)
+	assert.Equal(t, &aceSpec, tr.queries[0].AceSpec)
+	assert.Equal(t, "マスター", e.queries[0].Q)
+	// 検索語の言語はどの種類の検索にも渡す
+	assert.Equal(t, translation.En, p.queries[0].Lang)
+	assert.Equal(t, translation.En, tr.queries[0].Lang)
+	assert.Equal(t, translation.En, e.queries[0].Lang)
+	assert.Equal(t, int64(1), dto.Facets.Pokemons.Distribution["energy_type"]["水"])
+}
+
+func TestSearchPokemonAndTrainerList_PokemonOnlyFilters(t *testing.T) {
+	uc, p, tr, e := newStubUseCase()
+
+	dto, err := uc.SearchPokemonAndTrainerList(context.Background(), SearchCardRequestDto{
+		EnergyTypes: []string{"水"},
+		MinHp:       200,
+		Regulations: []string{"H"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, dto.Pokemons, 1)
+	assert.Empty(t, dto.Trainers)
+	assert.Empty(t, dto.Energies)
+	assert.Equal(t, pokemon.SearchPokemonQuery{EnergyTypes: []string{"水"}, MinHp: 200, Regulations: []string{"H"}, Page: paging.Default()}, p.queries[0])
+	// タイプやHPを持たない種類は検索しない
+	assert.Empty(t, tr.queries)
+	assert.Empty(t, e.queries)
+	assert.NotNil(t, dto.Facets.Trainers.Distribution)
+}
+
+func TestSearchPokemonAndTrainerList_TrainerOnlyFilters(t *testing.T) {
+	uc, p, tr, e := newStubUseCase()
+
+	dto, err := uc.SearchPokemonAndTrainerList(context.Background(), SearchCardRequestDto{
+		TrainerTypes: []string{"グッズ"},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, dto.Pokemons)
+	assert.Len(t, dto.Trainers, 1)
+	assert.Empty(t, dto.Energies)
+	assert.Empty(t, p.queries)
+	assert.Equal(t, []string{"グッズ"}, tr.queries[0].TrainerTypes)
+	assert.Empty(t, e.queries)
+}
+
+func TestSearchPokemonAndTrainerList_InvalidHpRange(t *testing.T) {
+	uc, p, _, _ := newStubUseCase()
+
+	_, err := uc.SearchPokemonAndTrainerList(context.Background(), SearchCardRequestDto{MinHp: 200, MaxHp: 100})
+
+	assert.ErrorIs(t, err, ErrInvalidSearchParams)
+	assert.Empty(t, p.queries)
+}
+
+func TestSearchPokemonAndTrainerList_Page(t *testing.T) {
+	uc, p, tr, _ := newStubUseCase()
+
+	dto, err := uc.SearchPokemonAndTrainerList(context.Background(), SearchCardRequestDto{
+		Q:     "ex",
+		Page:  3,
+		Limit: 5,
+		Sort:  "hp",
+	})
+
+	assert.NoError(t, err)
+	want := paging.Page{Offset: 10, Limit: 5, Sort: paging.SortByHp}
+	assert.Equal(t, want, p.queries[0].Page)
+	assert.Equal(t, want, tr.queries[0].Page)
+	assert.Equal(t, 10, dto.Offset)
+	assert.Equal(t, 5, dto.Limit)
+	assert.Equal(t, "hp", dto.Sort)
+	assert.Equal(t, int64(42), dto.EstimatedTotalHits.Pokemons)
+}
+
+func TestSearchPokemonList_InvalidPage(t *testing.T) {
+	uc, p, _, _ := newStubUseCase()
+
+	_, err := uc.SearchPokemonList(context.Background(), SearchCardRequestDto{Limit: paging.MaxLimit + 1})
+
+	assert.ErrorIs(t, err, paging.ErrInvalidPage)
+	assert.Empty(t, p.queries)
+}
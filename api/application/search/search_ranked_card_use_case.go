@@ -0,0 +1,100 @@
+package search
+
+import (
+	"api/application/search/paging"
+	"api/domain"
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// SearchRankedCardUseCase ポケモン・トレーナー・エネルギーを1つの一覧にまとめ、キーワードに近い順に並べる
+type SearchRankedCardUseCase struct {
+	rankedCardQueryService RankedCardQueryService
+}
+
+func NewSearchRankedCardUseCase(rankedCardQueryService RankedCardQueryService) *SearchRankedCardUseCase {
+	return &SearchRankedCardUseCase{
+		rankedCardQueryService: rankedCardQueryService,
+	}
+}
+
+type SearchRankedCardUseCaseDto struct {
+	Cards              []*RankedCardDto `json:"cards"`
+	EstimatedTotalHits int64            `json:"estimated_total_hits"`
+	Offset             int              `json:"offset"`
+	Limit              int              `json:"limit"`
+}
+
+type RankedCardDto struct {
+	CardType    string  `json:"card_type"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	ImageURL    string  `json:"image_url"`
+	EnergyType  string  `json:"energy_type"`
+	Hp          int     `json:"hp"`
+	TrainerType string  `json:"trainer_type"`
+	Description string  `json:"description"`
+	Score       float64 `json:"score"`
+}
+
+// SearchRankedCardList cardTypeが0のときはすべての種類から探す
+// 種類の違うカードを比べられるのはスコアだけなので、並び順は指定できない
+func (uc *SearchRankedCardUseCase) SearchRankedCardList(ctx context.Context, cardType domain.CardType, req SearchCardRequestDto) (*SearchRankedCardUseCaseDto, error) {
+	if err := req.validateHp(); err != nil {
+		return nil, err
+	}
+	sort := req.Sort
+	if sort == "" {
+		sort = string(paging.SortByRelevance)
+	}
+	page, err := paging.NewPage(req.Page, req.Offset, req.Limit, sort, paging.SortByRelevance)
+	if err != nil {
+		return nil, err
+	}
+
+	query := SearchRankedCardQuery{Page: page}
+	if (cardType == 0 || cardType == domain.Pokemon) && !req.hasTrainerFilter() {
+		q := req.pokemonQuery(page)
+		query.Pokemon = &q
+	}
+	if (cardType == 0 || cardType == domain.Trainer) && !req.hasPokemonFilter() {
+		q := req.trainerQuery(page)
+		query.Trainer = &q
+	}
+	if (cardType == 0 || cardType == domain.Energy) && !req.hasPokemonFilter() && !req.hasTrainerFilter() {
+		q := req.energyQuery(page)
+		query.Energy = &q
+	}
+
+	dto := &SearchRankedCardUseCaseDto{
+		Cards:  make([]*RankedCardDto, 0),
+		Offset: page.Offset,
+		Limit:  page.Limit,
+	}
+	if query.Pokemon == nil && query.Trainer == nil && query.Energy == nil {
+		return dto, nil
+	}
+
+	result, err := uc.rankedCardQueryService.SearchRankedCardList(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	dto.Cards = lo.Map(result.Cards, func(f *RankedCard, _ int) *RankedCardDto {
+		return &RankedCardDto{
+			CardType:    f.CardType,
+			ID:          fmt.Sprintf("%v", f.ID),
+			Name:        f.Name,
+			ImageURL:    f.ImageURL,
+			EnergyType:  f.EnergyType,
+			Hp:          f.Hp,
+			TrainerType: f.TrainerType,
+			Description: f.Description,
+			Score:       f.Score,
+		}
+	})
+	dto.EstimatedTotalHits = result.EstimatedTotalHits
+	return dto, nil
+}
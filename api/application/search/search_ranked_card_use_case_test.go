@@ -0,0 +1,83 @@
+package search
+
+import (
+	"api/application/search/paging"
+	"api/domain"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubRankedCardQueryService struct {
+	queries []SearchRankedCardQuery
+}
+
+func (s *stubRankedCardQueryService) SearchRankedCardList(_ context.Context, query SearchRankedCardQuery) (*SearchRankedCardResult, error) {
+	s.queries = append(s.queries, query)
+	return &SearchRankedCardResult{
+		Cards: []*RankedCard{
+			{CardType: "energy", ID: 3, Name: "基本水エネルギー", Score: 1},
+			{CardType: "pokemon", ID: 1, Name: "ウォッシュロトム", Score: 0.6},
+		},
+		EstimatedTotalHits: 2,
+	}, nil
+}
+
+func TestSearchRankedCardList_AllTypes(t *testing.T) {
+	s := &stubRankedCardQueryService{}
+	uc := NewSearchRankedCardUseCase(s)
+
+	dto, err := uc.SearchRankedCardList(context.Background(), 0, SearchCardRequestDto{Q: "水", Regulations: []string{"H"}})
+
+	assert.NoError(t, err)
+	assert.Len(t, s.queries, 1)
+	assert.Equal(t, []string{"H"}, s.queries[0].Pokemon.Regulations)
+	assert.Equal(t, "水", s.queries[0].Trainer.Q)
+	assert.Equal(t, "水", s.queries[0].Energy.Q)
+	assert.Equal(t, paging.SortByRelevance, s.queries[0].Page.Sort)
+	assert.Equal(t, "energy", dto.Cards[0].CardType)
+	assert.Equal(t, "3", dto.Cards[0].ID)
+	assert.Equal(t, 1.0, dto.Cards[0].Score)
+	assert.Equal(t, int64(2), dto.EstimatedTotalHits)
+}
+
+func TestSearchRankedCardList_NarrowsTypes(t *testing.T) {
+	s := &stubRankedCardQueryService{}
+	uc := NewSearchRankedCardUseCase(s)
+
+	_, err := uc.SearchRankedCardList(context.Background(), 0, SearchCardRequestDto{TrainerTypes: []string{"サポート"}})
+	assert.NoError(t, err)
+	assert.Nil(t, s.queries[0].Pokemon)
+	assert.NotNil(t, s.queries[0].Trainer)
+	assert.Nil(t, s.queries[0].Energy)
+
+	_, err = uc.SearchRankedCardList(context.Background(), domain.Energy, SearchCardRequestDto{Q: "水"})
+	assert.NoError(t, err)
+	assert.Nil(t, s.queries[1].Pokemon)
+	assert.Nil(t, s.queries[1].Trainer)
+	assert.NotNil(t, s.queries[1].Energy)
+}
+
+func TestSearchRankedCardList_NoMatchingType(t *testing.T) {
+	s := &stubRankedCardQueryService{}
+	uc := NewSearchRankedCardUseCase(s)
+
+	// エネルギーはHPを持たないので何もヒットしない
+	dto, err := uc.SearchRankedCardList(context.Background(), domain.Energy, SearchCardRequestDto{MinHp: 100})
+
+	assert.NoError(t, err)
+	assert.Empty(t, s.queries)
+	assert.Empty(t, dto.Cards)
+}
+
+func TestSearchRankedCardList_OnlyRelevance(t *testing.T) {
+	s := &stubRankedCardQueryService{}
+	uc := NewSearchRankedCardUseCase(s)
+
+	_, err := uc.SearchRankedCardList(context.Background(), 0, SearchCardRequestDto{Sort: "name"})
+
+	assert.True(t, errors.Is(err, paging.ErrInvalidPage))
+	assert.Empty(t, s.queries)
+}
@@ -0,0 +1,30 @@
+package suggest
+
+import "context"
+
+type CardName struct {
+	ID       int
+	CardType string
+	Name     string
+}
+
+// Suggestion 同じ名前の再録カードは1件にまとめる。IDは最も新しいカード
+type Suggestion struct {
+	ID       int
+	CardType string
+	Name     string
+	// 同じ名前のカードの数
+	Prints int
+}
+
+type CardNameQueryService interface {
+	// 新しいカードから順に返す
+	ListCardNames(ctx context.Context) ([]CardName, error)
+}
+
+// CardNameIndex 入力中の文字列からカード名を探すためのメモリ上の索引
+type CardNameIndex interface {
+	// Rebuild 索引を作り直し、まとめた後の名前の数を返す
+	Rebuild(names []CardName) int
+	Suggest(prefix string, limit int) []Suggestion
+}
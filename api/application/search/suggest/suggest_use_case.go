@@ -0,0 +1,83 @@
+package suggest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidSuggestParams = errors.New("invalid suggest params")
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 20
+)
+
+type SuggestUseCase struct {
+	cardNameQueryService CardNameQueryService
+	cardNameIndex        CardNameIndex
+}
+
+func NewSuggestUseCase(cardNameQueryService CardNameQueryService, cardNameIndex CardNameIndex) *SuggestUseCase {
+	return &SuggestUseCase{
+		cardNameQueryService: cardNameQueryService,
+		cardNameIndex:        cardNameIndex,
+	}
+}
+
+type SuggestionDto struct {
+	ID       string `json:"id"`
+	CardType string `json:"card_type"`
+	Name     string `json:"name"`
+	Prints   int    `json:"prints"`
+}
+
+type RefreshDto struct {
+	Cards       int       `json:"cards"`
+	Names       int       `json:"names"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
+
+// Suggest キーストロークごとに呼ばれるので、DBには問い合わせずメモリ上の索引だけを使う
+// limitが0のときはDefaultLimit件返す
+func (uc *SuggestUseCase) Suggest(ctx context.Context, q string, limit int) ([]*SuggestionDto, error) {
+	if limit < 0 || limit > MaxLimit {
+		return nil, fmt.Errorf("%w: limit は1以上%d以下で指定してください", ErrInvalidSuggestParams, MaxLimit)
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return []*SuggestionDto{}, nil
+	}
+
+	suggestions := uc.cardNameIndex.Suggest(q, limit)
+	return lo.Map(suggestions, func(s Suggestion, _ int) *SuggestionDto {
+		return &SuggestionDto{
+			ID:       fmt.Sprintf("%v", s.ID),
+			CardType: s.CardType,
+			Name:     s.Name,
+			Prints:   s.Prints,
+		}
+	}), nil
+}
+
+// Refresh カードの一覧を読み直して索引を作り直す。起動時とカードをインポートした後に呼び出す
+// 読み込みに失敗したときは前の索引のまま
+func (uc *SuggestUseCase) Refresh(ctx context.Context) (*RefreshDto, error) {
+	names, err := uc.cardNameQueryService.ListCardNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n := uc.cardNameIndex.Rebuild(names)
+	return &RefreshDto{
+		Cards:       len(names),
+		Names:       n,
+		RefreshedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package suggest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubCardNameQueryService struct {
+	names []CardName
+	err   error
+}
+
+func (s *stubCardNameQueryService) ListCardNames(_ context.Context) ([]CardName, error) {
+	return s.names, s.err
+}
+
+type stubCardNameIndex struct {
+	rebuilt  [][]CardName
+	prefixes []string
+	limits   []int
+}
+
+func (s *stubCardNameIndex) Rebuild(names []CardName) int {
+	s.rebuilt = append(s.rebuilt, names)
+	return 1
+}
+
+func (s *stubCardNameIndex) Suggest(prefix string, limit int) []Suggestion {
+	s.prefixes = append(s.prefixes, prefix)
+	s.limits = append(s.limits, limit)
+	return []Suggestion{{ID: 12, CardType: "pokemon", Name: "リザードンex", Prints: 3}}
+}
+
+func TestSuggest(t *testing.T) {
+	index := &stubCardNameIndex{}
+	uc := NewSuggestUseCase(&stubCardNameQueryService{}, index)
+
+	dto, err := uc.Suggest(context.Background(), " りざ ", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"りざ"}, index.prefixes)
+	assert.Equal(t, []int{DefaultLimit}, index.limits)
+	assert.Equal(t, &SuggestionDto{ID: "12", CardType: "pokemon", Name: "リザードンex", Prints: 3}, dto[0])
+}
+
+func TestSuggest_EmptyQuery(t *testing.T) {
+	index := &stubCardNameIndex{}
+	uc := NewSuggestUseCase(&stubCardNameQueryService{}, index)
+
+	dto, err := uc.Suggest(context.Background(), "", 5)
+
+	assert.NoError(t, err)
+	assert.Empty(t, dto)
+	assert.Empty(t, index.prefixes)
+}
+
+func TestSuggest_InvalidLimit(t *testing.T) {
+	uc := NewSuggestUseCase(&stubCardNameQueryService{}, &stubCardNameIndex{})
+
+	_, err := uc.Suggest(context.Background(), "リ", MaxLimit+1)
+	assert.True(t, errors.Is(err, ErrInvalidSuggestParams))
+
+	_, err = uc.Suggest(context.Background(), "リ", -1)
+	assert.True(t, errors.Is(err, ErrInvalidSuggestParams))
+}
+
+func TestRefresh_KeepsIndexOnError(t *testing.T) {
+	index := &stubCardNameIndex{}
+	uc := NewSuggestUseCase(&stubCardNameQueryService{err: errors.New("connection refused")}, index)
+
+	_, err := uc.Refresh(context.Background())
+
+	assert.Error(t, err)
+	assert.Empty(t, index.rebuilt)
+}
+
+func TestRefresh(t *testing.T) {
+	index := &stubCardNameIndex{}
+	names := []CardName{{ID: 2, CardType: "pokemon", Name: "リザードンex"}, {ID: 1, CardType: "pokemon", Name: "リザードンex"}}
+	uc := NewSuggestUseCase(&stubCardNameQueryService{names: names}, index)
+
+	dto, err := uc.Refresh(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dto.Cards)
+	assert.Equal(t, 1, dto.Names)
+	assert.Equal(t, [][]CardName{names}, index.rebuilt)
+}
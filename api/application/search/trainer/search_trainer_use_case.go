@@ -1,6 +1,7 @@
 package trainer
 
 import (
+	"api/application/search/paging"
 	"context"
 	"fmt"
 )
@@ -17,13 +18,13 @@ type SearchTrainerUseCaseDto struct {
 }
 
 func (uc *SearchTrainerUseCase) SearchTrainerList(ctx context.Context, q string) ([]*SearchTrainerUseCaseDto, error) {
-	searchTrainerList, err := uc.trainerQueryService.SearchTrainerList(ctx, q)
+	result, err := uc.trainerQueryService.SearchTrainerList(ctx, SearchTrainerQuery{Q: q, Page: paging.Default()})
 	if err != nil {
 		return nil, err
 	}
 
 	var dtoList []*SearchTrainerUseCaseDto
-	for _, f := range searchTrainerList {
+	for _, f := range result.Trainers {
 		dto := &SearchTrainerUseCaseDto{
 			ID:          fmt.Sprintf("%v", f.ID),
 			Name:        f.Name,
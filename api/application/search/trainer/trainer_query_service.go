@@ -1,6 +1,11 @@
 package trainer
 
-import "context"
+import (
+	"api/application/search/facet"
+	"api/application/search/paging"
+	"api/application/translation"
+	"context"
+)
 
 type SearchTrainerList struct {
 	ID          int    `json:"id"`
@@ -9,6 +14,23 @@ type SearchTrainerList struct {
 	ImageURL    string `json:"image_url"`
 }
 
+// SearchTrainerQuery 空のスライス・nilの条件では絞り込まない
+type SearchTrainerQuery struct {
+	Q            string
+	Lang         translation.Lang
+	TrainerTypes []string
+	Regulations  []string
+	Expansions   []string
+	AceSpec      *bool
+	Page         paging.Page
+}
+
+type SearchTrainerResult struct {
+	Trainers           []*SearchTrainerList
+	Facets             facet.Facets
+	EstimatedTotalHits int64
+}
+
 type TrainerQueryService interface {
-	SearchTrainerList(ctx context.Context, q string) ([]*SearchTrainerList, error)
+	SearchTrainerList(ctx context.Context, query SearchTrainerQuery) (*SearchTrainerResult, error)
 }
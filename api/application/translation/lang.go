@@ -0,0 +1,72 @@
+package translation
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Lang レスポンスの言語。カードは日本語で登録されているので、Ja のときは訳さない
+type Lang string
+
+const (
+	Ja Lang = "ja"
+	En Lang = "en"
+)
+
+var ErrUnsupportedLang = errors.New("unsupported lang")
+
+var supportedLangs = []Lang{Ja, En}
+
+// ParseLang lang パラメーターを読む。en-US のように地域が付いていても言語だけを見る
+func ParseLang(s string) (Lang, error) {
+	if lang, ok := findLang(s); ok {
+		return lang, nil
+	}
+	return "", ErrUnsupportedLang
+}
+
+// NegotiateLang Accept-Language のうち対応している言語を q の大きい順に探す。見つからなければ Ja
+func NegotiateLang(acceptLanguage string) Lang {
+	type candidate struct {
+		lang Lang
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		lang, ok := findLang(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{lang: lang, q: q})
+		}
+	}
+	if len(candidates) == 0 {
+		return Ja
+	}
+	// q が同じなら先に書かれた言語を優先する
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
+
+func findLang(tag string) (Lang, bool) {
+	primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	primary = strings.ToLower(primary)
+	for _, lang := range supportedLangs {
+		if primary == string(lang) {
+			return lang, true
+		}
+	}
+	return "", false
+}
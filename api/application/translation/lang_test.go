@@ -0,0 +1,55 @@
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLang(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Lang
+		wantErr bool
+	}{
+		{in: "ja", want: Ja},
+		{in: "en", want: En},
+		{in: "EN", want: En},
+		{in: "en-US", want: En},
+		{in: "fr", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLang(tt.in)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedLang)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNegotiateLang(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Lang
+	}{
+		{name: "未指定", in: "", want: Ja},
+		{name: "英語だけ", in: "en-US,en;q=0.9", want: En},
+		{name: "qの大きい言語", in: "ja;q=0.5, en;q=0.8", want: En},
+		{name: "qが同じなら先の言語", in: "en,ja", want: En},
+		{name: "対応していない言語は飛ばす", in: "fr-FR,fr;q=0.9,en;q=0.5", want: En},
+		{name: "q=0は使わない", in: "en;q=0", want: Ja},
+		{name: "どれにも対応していない", in: "fr, de", want: Ja},
+		{name: "ワイルドカード", in: "*", want: Ja},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NegotiateLang(tt.in))
+		})
+	}
+}
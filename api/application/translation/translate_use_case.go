@@ -0,0 +1,34 @@
+package translation
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+)
+
+type TranslateUseCase struct {
+	translationQueryService TranslationQueryService
+}
+
+func NewTranslateUseCase(translationQueryService TranslationQueryService) *TranslateUseCase {
+	return &TranslateUseCase{
+		translationQueryService: translationQueryService,
+	}
+}
+
+// Dictionary レスポンスに出てくる語の訳をまとめて1回で引く。Ja のときはDBに問い合わせない
+func (uc *TranslateUseCase) Dictionary(ctx context.Context, lang Lang, sources []string) (Dictionary, error) {
+	if lang == Ja {
+		return Dictionary{}, nil
+	}
+	sources = lo.Uniq(lo.Compact(sources))
+	if len(sources) == 0 {
+		return Dictionary{}, nil
+	}
+
+	translations, err := uc.translationQueryService.FindTranslations(ctx, lang, sources)
+	if err != nil {
+		return Dictionary{}, err
+	}
+	return NewDictionary(translations), nil
+}
@@ -0,0 +1,57 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTranslationQueryService struct {
+	translations []Translation
+	err          error
+	calls        [][]string
+}
+
+func (s *stubTranslationQueryService) FindTranslations(_ context.Context, _ Lang, sources []string) ([]Translation, error) {
+	s.calls = append(s.calls, sources)
+	return s.translations, s.err
+}
+
+func TestDictionary(t *testing.T) {
+	qs := &stubTranslationQueryService{translations: []Translation{
+		{Kind: CardName, Source: "ピカチュウex", Text: "Pikachu ex"},
+		{Kind: Label, Source: "雷", Text: "Lightning"},
+	}}
+	uc := NewTranslateUseCase(qs)
+
+	dict, err := uc.Dictionary(context.Background(), En, []string{"ピカチュウex", "雷", "", "雷", "10まんボルト"})
+	assert.NoError(t, err)
+	// 空文字と重複は問い合わせない
+	assert.Equal(t, [][]string{{"ピカチュウex", "雷", "10まんボルト"}}, qs.calls)
+
+	assert.Equal(t, "Pikachu ex", dict.Text(CardName, "ピカチュウex"))
+	assert.Equal(t, "Lightning", dict.Text(Label, "雷"))
+	// 訳がない語と、種類の違う語は日本語のまま
+	assert.Equal(t, "10まんボルト", dict.Text(EffectName, "10まんボルト"))
+	assert.Equal(t, "雷", dict.Text(CardName, "雷"))
+}
+
+func TestDictionaryJa(t *testing.T) {
+	qs := &stubTranslationQueryService{}
+	uc := NewTranslateUseCase(qs)
+
+	dict, err := uc.Dictionary(context.Background(), Ja, []string{"ピカチュウex"})
+	assert.NoError(t, err)
+	assert.Empty(t, qs.calls)
+	assert.Equal(t, "ピカチュウex", dict.Text(CardName, "ピカチュウex"))
+}
+
+func TestDictionaryError(t *testing.T) {
+	qs := &stubTranslationQueryService{err: errors.New("db down")}
+	uc := NewTranslateUseCase(qs)
+
+	_, err := uc.Dictionary(context.Background(), En, []string{"ピカチュウex"})
+	assert.Error(t, err)
+}
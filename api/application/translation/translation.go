@@ -0,0 +1,51 @@
+package translation
+
+import "context"
+
+// Kind 同じ表記でもカード名とワザ名で訳が違うことがあるので、種類ごとに訳を持つ
+type Kind string
+
+const (
+	CardName Kind = "card_name"
+	// ワザと特性の名前
+	EffectName Kind = "effect_name"
+	// エネルギーのタイプ、トレーナーズの種類、進化段階の表示名
+	Label Kind = "label"
+)
+
+type Translation struct {
+	Kind   Kind
+	Source string
+	Text   string
+}
+
+type TranslationQueryService interface {
+	// sources の訳のうち、lang で登録されているものを返す
+	FindTranslations(ctx context.Context, lang Lang, sources []string) ([]Translation, error)
+}
+
+// Dictionary 1つのレスポンスで使う訳。ゼロ値は何も訳さない
+type Dictionary struct {
+	texts map[entry]string
+}
+
+type entry struct {
+	kind   Kind
+	source string
+}
+
+func NewDictionary(translations []Translation) Dictionary {
+	texts := make(map[entry]string, len(translations))
+	for _, t := range translations {
+		texts[entry{kind: t.Kind, source: t.Source}] = t.Text
+	}
+	return Dictionary{texts: texts}
+}
+
+// Text 訳が登録されていない語は日本語のまま返す
+func (d Dictionary) Text(kind Kind, source string) string {
+	if text, ok := d.texts[entry{kind: kind, source: source}]; ok {
+		return text
+	}
+	return source
+}
@@ -5,6 +5,7 @@ import (
 	"api/infrastructure/mysql/db"
 	"api/server"
 	"context"
+	"log"
 )
 
 func main() {
@@ -12,6 +13,10 @@ func main() {
 	defer cancel()
 
 	conf := config.GetConfig()
+	// 空の鍵で署名すると誰でもトークンを作れてしまうため起動しない
+	if conf.Auth.JWTSecret == "" {
+		log.Fatal("JWT_SECRET is not set")
+	}
 	db.NewMainDB(conf.DB)
 
 	server.Run(ctx)
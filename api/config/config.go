@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -14,6 +15,10 @@ type Config struct {
 	Server      Server
 	DB          DBConfig
 	MeiliConfig MeiliConfig
+	Auth        AuthConfig
+	Cache       CacheConfig
+	Admin       AdminConfig
+	Search      SearchConfig
 }
 
 type DBConfig struct {
@@ -37,6 +42,36 @@ type MeiliConfig struct {
 	ApiKey   string `envconfig:"MEILI_API_KEY"`
 }
 
+// AuthConfig APIが発行するセッショントークンの設定
+type AuthConfig struct {
+	JWTSecret string        `envconfig:"JWT_SECRET"`
+	TokenTTL  time.Duration `envconfig:"JWT_TTL" default:"24h"`
+	// APIキーごとに1秒あたり補充するリクエスト数と、まとめて送れるリクエスト数の上限
+	ApiKeyRateLimit float64 `envconfig:"API_KEY_RATE_LIMIT" default:"5"`
+	ApiKeyBurst     int     `envconfig:"API_KEY_BURST" default:"30"`
+}
+
+// CacheConfig APIサーバーのメモリに保持するキャッシュの設定
+type CacheConfig struct {
+	// 保持するカードの上限。0の場合はキャッシュしない
+	CardCacheSize int `envconfig:"CARD_CACHE_SIZE" default:"20000"`
+}
+
+// AdminConfig 運用向けAPIの設定。トークンが空の場合は運用向けAPIを使えない
+type AdminConfig struct {
+	Token string `envconfig:"ADMIN_TOKEN"`
+}
+
+// SearchConfig Meilisearchが使えないときにMySQLで検索する切り替えの設定
+type SearchConfig struct {
+	// Meilisearchへの検索がこの回数続けて失敗したらMySQLに切り替える
+	BreakerThreshold int `envconfig:"SEARCH_BREAKER_THRESHOLD" default:"3"`
+	// 切り替えてからMeilisearchを試し直すまでの時間
+	BreakerCooldown time.Duration `envconfig:"SEARCH_BREAKER_COOLDOWN" default:"30s"`
+	// Meilisearchのヘルスチェックの間隔。0の場合はヘルスチェックしない
+	HealthInterval time.Duration `envconfig:"SEARCH_HEALTH_INTERVAL" default:"10s"`
+}
+
 var (
 	once   sync.Once
 	config Config
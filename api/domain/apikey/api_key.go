@@ -0,0 +1,129 @@
+package apikey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Scope string
+
+const (
+	// カード・デッキの検索
+	ScopeSearchRead Scope = "search:read"
+	// 自分のデッキ一覧の取得
+	ScopeDeckRead Scope = "deck:read"
+	// デッキの作成・編集・削除。deck:readも含む
+	ScopeDeckWrite Scope = "deck:write"
+)
+
+var scopes = map[Scope]struct{}{
+	ScopeSearchRead: {},
+	ScopeDeckRead:   {},
+	ScopeDeckWrite:  {},
+}
+
+var ErrUnknownScope = errors.New("unknown scope")
+
+type ApiKey struct {
+	id     int
+	userId string
+	name   string
+	// 一覧で見分けるためのキーの先頭部分。キー全体は保存しない
+	prefix string
+	// キーのSHA-256ハッシュ(16進数)
+	keyHash   string
+	scopes    []Scope
+	revokedAt *time.Time
+	createdAt time.Time
+}
+
+func NewApiKey(id int, userId string, name string, prefix string, keyHash string, scopes []Scope, revokedAt *time.Time, createdAt time.Time) (*ApiKey, error) {
+	if userId == "" {
+		return nil, errors.New("user id is required")
+	}
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if keyHash == "" {
+		return nil, errors.New("key hash is required")
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	return &ApiKey{
+		id:        id,
+		userId:    userId,
+		name:      name,
+		prefix:    prefix,
+		keyHash:   keyHash,
+		scopes:    scopes,
+		revokedAt: revokedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+// ParseScopes 文字列のスコープを検証し、重複を取り除く
+func ParseScopes(values []string) ([]Scope, error) {
+	var parsed []Scope
+	seen := map[Scope]bool{}
+	for _, v := range values {
+		s := Scope(strings.TrimSpace(v))
+		if _, ok := scopes[s]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownScope, v)
+		}
+		if !seen[s] {
+			seen[s] = true
+			parsed = append(parsed, s)
+		}
+	}
+	return parsed, nil
+}
+
+// HasScope deck:writeを持つキーはdeck:readも持つものとして扱う
+func (k *ApiKey) HasScope(scope Scope) bool {
+	for _, s := range k.scopes {
+		if s == scope || (s == ScopeDeckWrite && scope == ScopeDeckRead) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *ApiKey) IsRevoked() bool {
+	return k.revokedAt != nil
+}
+
+func (k *ApiKey) GetId() int {
+	return k.id
+}
+
+func (k *ApiKey) GetUserId() string {
+	return k.userId
+}
+
+func (k *ApiKey) GetName() string {
+	return k.name
+}
+
+func (k *ApiKey) GetPrefix() string {
+	return k.prefix
+}
+
+func (k *ApiKey) GetKeyHash() string {
+	return k.keyHash
+}
+
+func (k *ApiKey) GetScopes() []Scope {
+	return k.scopes
+}
+
+func (k *ApiKey) GetRevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+func (k *ApiKey) GetCreatedAt() time.Time {
+	return k.createdAt
+}
@@ -0,0 +1,17 @@
+package apikey
+
+import "context"
+
+type ApiKeyRepository interface {
+	// APIキーの作成
+	Create(ctx context.Context, key *ApiKey) (*ApiKey, error)
+
+	// キーのハッシュからAPIキーを取得。無効化したキーも返す
+	FindByHash(ctx context.Context, keyHash string) (*ApiKey, error)
+
+	// ユーザーのAPIキー一覧取得
+	FindByUserId(ctx context.Context, userId string) ([]*ApiKey, error)
+
+	// APIキーの無効化。他のユーザーのキーや無効化済みのキーはNotFoundErrになる
+	Revoke(ctx context.Context, id int, userId string) error
+}
@@ -0,0 +1,69 @@
+package apikey
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes([]string{"search:read", " deck:write", "search:read"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(scopes) != 2 || scopes[0] != ScopeSearchRead || scopes[1] != ScopeDeckWrite {
+		t.Errorf("unexpected scopes: %v", scopes)
+	}
+
+	if _, err := ParseScopes([]string{"admin"}); !errors.Is(err, ErrUnknownScope) {
+		t.Errorf("expected ErrUnknownScope, got %v", err)
+	}
+}
+
+func TestApiKey_HasScope(t *testing.T) {
+	tests := map[string]struct {
+		scopes []Scope
+		scope  Scope
+		want   bool
+	}{
+		"same scope":                {[]Scope{ScopeSearchRead}, ScopeSearchRead, true},
+		"search key cannot write":   {[]Scope{ScopeSearchRead}, ScopeDeckWrite, false},
+		"write implies read":        {[]Scope{ScopeDeckWrite}, ScopeDeckRead, true},
+		"read does not imply write": {[]Scope{ScopeDeckRead}, ScopeDeckWrite, false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			key, err := NewApiKey(1, "user-1", "script", "ab12cd34", "hash", tt.scopes, nil, time.Now())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := key.HasScope(tt.scope); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	key, prefix, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !LooksLikeKey(key) {
+		t.Errorf("generated key has unexpected format: %s", key)
+	}
+	if len(prefix) != displayPrefixLength || key[:len(prefix)] != prefix {
+		t.Errorf("unexpected prefix %q for %q", prefix, key)
+	}
+	if HashKey(key) == HashKey(key+"x") || len(HashKey(key)) != 64 {
+		t.Errorf("unexpected hash: %s", HashKey(key))
+	}
+
+	other, _, _ := GenerateKey()
+	if other == key {
+		t.Error("expected keys to be random")
+	}
+	if LooksLikeKey("ptcg_short") {
+		t.Error("expected short value to be rejected")
+	}
+}
@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// ログやソースコードに紛れ込んだときに見つけやすくするための接頭辞
+	keyPrefix = "ptcg_"
+	// 一覧に表示する先頭部分の長さ(接頭辞を含む)
+	displayPrefixLength = 12
+	keyBytes            = 30
+)
+
+// GenerateKey 新しいキーと一覧表示用の先頭部分を作る。キーはこのときしか平文で扱わない
+func GenerateKey() (key string, prefix string, err error) {
+	buf := make([]byte, keyBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	key = keyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return key, key[:displayPrefixLength], nil
+}
+
+// HashKey 保存・照合に使うハッシュ。キーは十分に長い乱数なのでソルトなしのSHA-256で足りる
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// LooksLikeKey 形式が違う値はDBに問い合わせずに弾く
+func LooksLikeKey(key string) bool {
+	return strings.HasPrefix(key, keyPrefix) && len(key) == len(keyPrefix)+base64.RawURLEncoding.EncodedLen(keyBytes)
+}
@@ -5,5 +5,30 @@ type Card interface {
 	GetName() string
 	GetCardType() int
 	GetImageUrl() string
+	GetRegulation() string
+	GetExpansion() string
 	IsAceSpec() bool
+	IsRadiant() bool
+	IsPrismStar() bool
+}
+
+// SpecialRules デッキに入れられる枚数が特別に制限されるカードのフラグ
+type SpecialRules struct {
+	AceSpec   bool
+	Radiant   bool
+	PrismStar bool
+	// ex・V・GXなど、ルールを持つポケモン
+	RuleBox bool
+}
+
+// 進化の情報を持つポケモンのカード
+type PokemonCard interface {
+	Card
+	GetStage() string
+	GetEvolvesFrom() string
+	IsBasic() bool
+	// 進化段階が登録されていないカードはfalse
+	IsStageKnown() bool
+	GetEnergyType() string
+	HasRuleBox() bool
 }
@@ -2,14 +2,22 @@ package deck
 
 import (
 	"api/domain"
-	"fmt"
+	"errors"
 	"strings"
+
+	"github.com/samber/lo"
 )
 
+// 他のユーザーのデッキを編集・削除しようとした
+var ErrNotDeckOwner = errors.New("not deck owner")
+
 type Deck struct {
-	id          int
+	id int
+	// 作成したユーザーのID。認証が導入される前に作られたデッキは空になる
+	ownerId     string
 	name        string
 	description string
+	format      *Format
 	mainCard    domain.Card
 	subCard     domain.Card
 	cards       []DeckCard
@@ -21,19 +29,8 @@ type DeckCard struct {
 	isAceSpec bool // エーススペックフラグを追加
 }
 
-type DeckValidationError struct {
-	Message string
-}
-
-func NewDeck(id int, name string, description string, mainCard domain.Card, subCard domain.Card, cards []DeckCard) (*Deck, []error) {
-	deck := &Deck{
-		id:          id,
-		name:        name,
-		description: description,
-		mainCard:    mainCard,
-		subCard:     subCard,
-		cards:       cards,
-	}
+func NewDeck(id int, ownerId string, name string, description string, format *Format, mainCard domain.Card, subCard domain.Card, cards []DeckCard) (*Deck, []error) {
+	deck := NewDeckWithoutValidation(id, ownerId, name, description, format, mainCard, subCard, cards)
 
 	errors := deck.Validate()
 	if len(errors) > 0 {
@@ -44,11 +41,17 @@ func NewDeck(id int, name string, description string, mainCard domain.Card, subC
 }
 
 // NewDeckWithoutValidation creates a deck without validation, for repository use only
-func NewDeckWithoutValidation(id int, name string, description string, mainCard domain.Card, subCard domain.Card, cards []DeckCard) *Deck {
+func NewDeckWithoutValidation(id int, ownerId string, name string, description string, format *Format, mainCard domain.Card, subCard domain.Card, cards []DeckCard) *Deck {
+	// フォーマットが導入される前のデッキは無制限として扱う
+	if format == nil {
+		format = formats[FormatUnlimited]
+	}
 	return &Deck{
 		id:          id,
+		ownerId:     ownerId,
 		name:        name,
 		description: description,
+		format:      format,
 		mainCard:    mainCard,
 		subCard:     subCard,
 		cards:       cards,
@@ -63,74 +66,47 @@ func NewDeckCard(card domain.Card, quantity int) *DeckCard {
 	}
 }
 
+// Validate デッキのフォーマットの構築ルールで検証する
 func (d *Deck) Validate() []error {
-	var errors []error
+	errs, _ := d.format.ruleset.Check(d)
+	return errs
+}
 
-	if d.name == "" {
-		errors = append(errors, DeckValidationError{
-			Message: "デッキ名 は必須です",
-		})
-	}
+// Warnings デッキとして登録はできるが、構築上問題がありそうな点を返す
+func (d *Deck) Warnings() []error {
+	_, warnings := d.format.ruleset.Check(d)
+	return warnings
+}
 
-	totalQuantity := 0
-	for _, card := range d.cards {
-		totalQuantity += card.quantity
-	}
-	if totalQuantity != 60 {
-		errors = append(errors, DeckValidationError{
-			Message: "カードの合計数は60枚です",
-		})
-	}
+func (d *Deck) contains(card domain.Card) bool {
+	return lo.ContainsBy(d.cards, func(deckCard DeckCard) bool {
+		return isSameCard(deckCard.card, card)
+	})
+}
 
-	mainCardCheck := false
-	subCardCheck := false
-
-	cardCounts := make(map[string]int)
-	for _, deckCard := range d.cards {
-		c := deckCard.card
-		if c.GetCardType() == int(domain.Energy) && strings.Contains(c.GetName(), "基本") {
-			continue
-		}
-		cardCounts[c.GetName()] += deckCard.quantity
-		// エーススペックは1枚のみ
-		if deckCard.IsAceSpec() {
-			if cardCounts[c.GetName()] > 1 {
-				errors = append(errors, DeckValidationError{
-					Message: fmt.Sprintf("エーススペック: %s が2枚以上登録されています", c.GetName()),
-				})
-			}
-		}
-		// 基本エネルギー以外は４枚まで
-		if cardCounts[c.GetName()] > 4 {
-			errors = append(errors, DeckValidationError{
-				Message: fmt.Sprintf("%s が5枚以上登録されています", c.GetName()),
-			})
-		}
-
-		if deckCard.card.GetId() == d.mainCard.GetId() {
-			mainCardCheck = true
-		}
-		if deckCard.card.GetId() == d.subCard.GetId() {
-			subCardCheck = true
-		}
-	}
+func countQuantity(cards []DeckCard) int {
+	return lo.SumBy(cards, func(deckCard DeckCard) int {
+		return deckCard.quantity
+	})
+}
 
-	if d.mainCard.GetId() != 0 && !mainCardCheck {
-		errors = append(errors, DeckValidationError{
-			Message: fmt.Sprintf("メインカード: %s がデッキに含まれていません", d.mainCard.GetName()),
-		})
-	}
-	if d.subCard.GetId() != 0 && !subCardCheck {
-		errors = append(errors, DeckValidationError{
-			Message: fmt.Sprintf("サブカード: %s がデッキに含まれていません", d.subCard.GetName()),
-		})
-	}
+func isBasicEnergy(c domain.Card) bool {
+	return c.GetCardType() == int(domain.Energy) && strings.Contains(c.GetName(), "基本")
+}
 
-	return errors
+func asPokemonCard(c domain.Card) (domain.PokemonCard, bool) {
+	if c.GetCardType() != int(domain.Pokemon) {
+		return nil, false
+	}
+	p, ok := c.(domain.PokemonCard)
+	return p, ok
 }
 
-func (e DeckValidationError) Error() string {
-	return e.Message
+func isSameCard(a domain.Card, b domain.Card) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.GetId() == b.GetId() && a.GetCardType() == b.GetCardType()
 }
 
 func (d *Deck) GetMainCard() domain.Card {
@@ -149,6 +125,18 @@ func (d *Deck) GetId() int {
 	return d.id
 }
 
+func (d *Deck) GetOwnerId() string {
+	return d.ownerId
+}
+
+// CanEdit 持ち主だけが編集・削除できる。持ち主のいないデッキは誰も編集できない
+func (d *Deck) CanEdit(userId string) bool {
+	if userId == "" {
+		return false
+	}
+	return d.ownerId == userId
+}
+
 func (d *Deck) GetName() string {
 	return d.name
 }
@@ -157,6 +145,10 @@ func (d *Deck) GetDescription() string {
 	return d.description
 }
 
+func (d *Deck) GetFormat() *Format {
+	return d.format
+}
+
 func (d *DeckCard) GetCard() domain.Card {
 	return d.card
 }
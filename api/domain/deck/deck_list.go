@@ -0,0 +1,131 @@
+package deck
+
+import (
+	"api/domain"
+	"errors"
+)
+
+// 一覧の続きを取得するためのカーソルが壊れている、または別の並び順で発行されたもの
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// 一覧の並び順に使う項目
+type SortKey string
+
+const (
+	SortByCreatedAt SortKey = "created_at"
+	SortByUpdatedAt SortKey = "updated_at"
+	SortByName      SortKey = "name"
+)
+
+var sortKeys = map[string]SortKey{
+	string(SortByCreatedAt): SortByCreatedAt,
+	string(SortByUpdatedAt): SortByUpdatedAt,
+	string(SortByName):      SortByName,
+}
+
+// FindSortKey 空の場合は作成日時順にする
+func FindSortKey(name string) (SortKey, bool) {
+	if name == "" {
+		return SortByCreatedAt, true
+	}
+	key, ok := sortKeys[name]
+	return key, ok
+}
+
+// CardFilter 絞り込みに使うカード
+type CardFilter struct {
+	Id       int
+	CardType domain.CardType
+}
+
+// ListCondition デッキ一覧の絞り込み・並び順・ページングの条件
+type ListCondition struct {
+	OwnerId string
+	// アカウント導入前に作成された、持ち主のいないデッキも含める
+	IncludeUnowned bool
+	// デッキ名の部分一致
+	Name   string
+	Format string
+	// デッキに入っているカード
+	Card     *CardFilter
+	MainCard *CardFilter
+
+	SortKey    SortKey
+	Descending bool
+	// 前のページの NextCursor。空の場合は先頭から取得する
+	Cursor string
+	Limit  int
+}
+
+// DeckPage 次のページがない場合 NextCursor は空になる
+type DeckPage struct {
+	Decks      []*Deck
+	NextCursor string
+}
+
+// DeckSummary 一覧表示用にカードリストを持たないデッキ
+type DeckSummary struct {
+	id          int
+	ownerId     string
+	name        string
+	description string
+	format      *Format
+	mainCard    domain.Card
+	subCard     domain.Card
+	cardCount   int
+}
+
+type DeckSummaryPage struct {
+	Summaries  []*DeckSummary
+	NextCursor string
+}
+
+func NewDeckSummary(id int, ownerId string, name string, description string, format *Format, mainCard domain.Card, subCard domain.Card, cardCount int) *DeckSummary {
+	return &DeckSummary{
+		id:          id,
+		ownerId:     ownerId,
+		name:        name,
+		description: description,
+		format:      format,
+		mainCard:    mainCard,
+		subCard:     subCard,
+		cardCount:   cardCount,
+	}
+}
+
+func (s *DeckSummary) GetId() int {
+	return s.id
+}
+
+func (s *DeckSummary) GetOwnerId() string {
+	return s.ownerId
+}
+
+func (s *DeckSummary) GetName() string {
+	return s.name
+}
+
+func (s *DeckSummary) GetDescription() string {
+	return s.description
+}
+
+func (s *DeckSummary) GetFormat() *Format {
+	return s.format
+}
+
+func (s *DeckSummary) GetMainCard() domain.Card {
+	return s.mainCard
+}
+
+func (s *DeckSummary) GetSubCard() domain.Card {
+	return s.subCard
+}
+
+func (s *DeckSummary) GetCardCount() int {
+	return s.cardCount
+}
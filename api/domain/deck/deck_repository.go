@@ -9,7 +9,11 @@ type DeckRepository interface {
 	// デッキの作成
 	Create(ctx context.Context, deck *Deck) (*Deck, error)
 
-	FindAll(ctx context.Context) ([]*Deck, error)
+	// 条件に合うデッキを1ページ分取得
+	FindPage(ctx context.Context, condition ListCondition) (*DeckPage, error)
+
+	// カードリストを読み込まずに、一覧表示用の概要を1ページ分取得
+	FindSummaryPage(ctx context.Context, condition ListCondition) (*DeckSummaryPage, error)
 
 	// デッキの詳細取得
 	FindById(ctx context.Context, id int) (*Deck, error)
@@ -21,8 +25,26 @@ type DeckRepository interface {
 	Delete(ctx context.Context, id int) error
 }
 
+// スナップショットはデッキの作成・更新と同じトランザクションで保存するため、ここでは読み込みだけを扱う
+type DeckVersionRepository interface {
+	// 新しい順にバージョン一覧を取得
+	FindByDeckId(ctx context.Context, deckId int) ([]*DeckVersion, error)
+
+	// 指定したバージョンの時点のデッキを取得
+	FindByVersion(ctx context.Context, deckId int, version int) (*DeckVersion, *Deck, error)
+}
+
+// CardKey カードはタイプごとに別のテーブルに入っているので、IDとタイプの組で識別する
+type CardKey struct {
+	Id       int
+	CardType domain.CardType
+}
+
 // カード情報を取得するためのリポジトリ
 type CardRepository interface {
 	// カードIDとタイプからカード情報を取得
 	FindCardById(ctx context.Context, cardId int, cardType domain.CardType) (domain.Card, error)
+
+	// 複数のカードをまとめて取得。見つからなかったカードは結果に含まれない
+	FindCardsByIds(ctx context.Context, keys []CardKey) (map[CardKey]domain.Card, error)
 }
@@ -0,0 +1,187 @@
+package deck
+
+import (
+	"api/domain"
+	"api/domain/energy"
+	"api/domain/pokemon"
+	"api/domain/trainer"
+	"testing"
+
+	"github.com/samber/lo"
+)
+
+func newTestPokemon(t *testing.T, id int, name string, stage string, evolvesFrom string, specialRules ...domain.SpecialRules) domain.Card {
+	t.Helper()
+	p, err := pokemon.NewPokemon(id, name, pokemon.Psychic, 100, "", "", "image.png", "G", "SV1", stage, evolvesFrom, nil, lo.FirstOr(specialRules, domain.SpecialRules{}))
+	if err != nil {
+		t.Fatalf("failed to create pokemon: %v", err)
+	}
+	return p
+}
+
+func newTestTrainer(t *testing.T, id int, name string, specialRules ...domain.SpecialRules) domain.Card {
+	t.Helper()
+	tr, err := trainer.NewTrainer(id, name, trainer.Item, "", "image.png", "G", "SV1", lo.FirstOr(specialRules, domain.SpecialRules{}))
+	if err != nil {
+		t.Fatalf("failed to create trainer: %v", err)
+	}
+	return tr
+}
+
+func newTestEnergy(t *testing.T, id int, name string, specialRules ...domain.SpecialRules) domain.Card {
+	t.Helper()
+	e, err := energy.NewEnergy(id, name, "image.png", "", "SVE", lo.FirstOr(specialRules, domain.SpecialRules{}))
+	if err != nil {
+		t.Fatalf("failed to create energy: %v", err)
+	}
+	return e
+}
+
+func TestDeck_Validate(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	kirlia := newTestPokemon(t, 2, "キルリア", pokemon.Stage1, "ラルトス")
+	gardevoir := newTestPokemon(t, 3, "サーナイトex", pokemon.Stage2, "キルリア")
+	ultraBall := newTestTrainer(t, 4, "ハイパーボール")
+	psychicEnergy := newTestEnergy(t, 5, "基本超エネルギー")
+	masterBall := newTestTrainer(t, 6, "マスターボール", domain.SpecialRules{AceSpec: true})
+	legacyEnergy := newTestEnergy(t, 7, "レガシーエネルギー", domain.SpecialRules{AceSpec: true})
+	radiantGreninja := newTestPokemon(t, 8, "かがやくゲッコウガ", pokemon.Basic, "", domain.SpecialRules{Radiant: true})
+	radiantCharizard := newTestPokemon(t, 9, "かがやくリザードン", pokemon.Basic, "", domain.SpecialRules{Radiant: true})
+	prismStar := newTestTrainer(t, 10, "ネクロズマ◇", domain.SpecialRules{PrismStar: true})
+
+	tests := map[string]struct {
+		cards         []DeckCard
+		expectErrors  int
+		expectWarning int
+	}{
+		"valid evolution line": {
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 4),
+				*NewDeckCard(kirlia, 3),
+				*NewDeckCard(gardevoir, 3),
+				*NewDeckCard(ultraBall, 4),
+				*NewDeckCard(psychicEnergy, 46),
+			},
+			expectErrors:  0,
+			expectWarning: 0,
+		},
+		"no basic pokemon": {
+			cards: []DeckCard{
+				*NewDeckCard(kirlia, 4),
+				*NewDeckCard(ultraBall, 4),
+				*NewDeckCard(psychicEnergy, 52),
+			},
+			expectErrors:  1,
+			expectWarning: 1,
+		},
+		"stage 2 without stage 1": {
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 4),
+				*NewDeckCard(gardevoir, 3),
+				*NewDeckCard(ultraBall, 4),
+				*NewDeckCard(psychicEnergy, 49),
+			},
+			expectErrors:  0,
+			expectWarning: 1,
+		},
+		"two different ace specs": {
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 4),
+				*NewDeckCard(masterBall, 1),
+				*NewDeckCard(legacyEnergy, 1),
+				*NewDeckCard(psychicEnergy, 54),
+			},
+			expectErrors:  1,
+			expectWarning: 0,
+		},
+		"two different radiant pokemon": {
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 4),
+				*NewDeckCard(radiantGreninja, 1),
+				*NewDeckCard(radiantCharizard, 1),
+				*NewDeckCard(psychicEnergy, 54),
+			},
+			expectErrors:  1,
+			expectWarning: 0,
+		},
+		"two copies of prism star": {
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 4),
+				*NewDeckCard(prismStar, 2),
+				*NewDeckCard(psychicEnergy, 54),
+			},
+			expectErrors:  1,
+			expectWarning: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, tt.cards)
+
+			if errs := d.Validate(); len(errs) != tt.expectErrors {
+				t.Errorf("expected %d errors, got %v", tt.expectErrors, errs)
+			}
+			if warnings := d.Warnings(); len(warnings) != tt.expectWarning {
+				t.Errorf("expected %d warnings, got %v", tt.expectWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestDeck_Validate_Violation(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	masterBall := newTestTrainer(t, 6, "マスターボール", domain.SpecialRules{AceSpec: true})
+	legacyEnergy := newTestEnergy(t, 7, "レガシーエネルギー", domain.SpecialRules{AceSpec: true})
+	psychicEnergy := newTestEnergy(t, 5, "基本超エネルギー")
+
+	d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(ralts, 4),
+		*NewDeckCard(masterBall, 1),
+		*NewDeckCard(legacyEnergy, 1),
+		*NewDeckCard(psychicEnergy, 54),
+	})
+
+	errs := d.Validate()
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	violation, ok := errs[0].(DeckValidationError)
+	if !ok {
+		t.Fatalf("expected DeckValidationError, got %T", errs[0])
+	}
+	if violation.Code != CodeAceSpecLimit || violation.Severity != SeverityError {
+		t.Errorf("unexpected code or severity: %s %s", violation.Code, violation.Severity)
+	}
+	if len(violation.Cards) != 2 || violation.Cards[0].Id != 6 || violation.Cards[1].Quantity != 1 {
+		t.Errorf("unexpected cards: %+v", violation.Cards)
+	}
+	if violation.Params["actual"] != 2 {
+		t.Errorf("unexpected params: %v", violation.Params)
+	}
+	if expected := "エーススペックはデッキに1枚までです: マスターボール, レガシーエネルギー"; violation.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, violation.Error())
+	}
+}
+
+func TestDeck_CanEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		ownerId string
+		userId  string
+		want    bool
+	}{
+		{"owner", "user-1", "user-1", true},
+		{"other user", "user-1", "user-2", false},
+		{"unowned deck", "", "user-2", false},
+		{"anonymous", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeckWithoutValidation(1, tt.ownerId, "テストデッキ", "", nil, nil, nil, nil)
+			if got := d.CanEdit(tt.userId); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package deck
+
+import (
+	"api/domain"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	FormatStandard  = "standard"
+	FormatExpanded  = "expanded"
+	FormatUnlimited = "unlimited"
+	FormatHalfDeck  = "half_deck"
+	FormatGLC       = "glc"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Format 使用できるレギュレーションマークと構築ルールの組み合わせ
+type Format struct {
+	name        string
+	displayName string
+	ruleset     *Ruleset
+	rotations   []Rotation
+	// マークが印刷される前のシリーズのうち、使用できるものの拡張パックの接頭辞
+	unmarkedSeries []string
+}
+
+// Rotation ある日付から有効になる使用可能なレギュレーションマークの組み合わせ
+type Rotation struct {
+	effectiveFrom time.Time
+	marks         []string
+}
+
+func NewFormat(name string, displayName string, ruleset *Ruleset, rotations ...Rotation) *Format {
+	sorted := append([]Rotation(nil), rotations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].effectiveFrom.Before(sorted[j].effectiveFrom)
+	})
+	return &Format{
+		name:        name,
+		displayName: displayName,
+		ruleset:     ruleset,
+		rotations:   sorted,
+	}
+}
+
+// WithUnmarkedSeries レギュレーションマークのないカードを拡張パックのシリーズで使用可能にしたフォーマットを返す
+func (f *Format) WithUnmarkedSeries(series ...string) *Format {
+	copied := *f
+	copied.unmarkedSeries = series
+	return &copied
+}
+
+func NewRotation(effectiveFrom time.Time, marks ...string) Rotation {
+	return Rotation{
+		effectiveFrom: effectiveFrom,
+		marks:         marks,
+	}
+}
+
+// 毎年1月の新弾発売日に新しいマークが追加され、最も古いマークがスタンダードから外れる
+var standardRotations = []Rotation{
+	NewRotation(time.Date(2024, 1, 26, 0, 0, 0, 0, jst), "F", "G", "H"),
+	NewRotation(time.Date(2025, 1, 24, 0, 0, 0, 0, jst), "G", "H", "I"),
+	NewRotation(time.Date(2026, 1, 23, 0, 0, 0, 0, jst), "H", "I", "J"),
+}
+
+var expandedRotations = []Rotation{
+	NewRotation(time.Date(2024, 1, 26, 0, 0, 0, 0, jst), "A", "B", "C", "D", "E", "F", "G", "H"),
+	NewRotation(time.Date(2025, 1, 24, 0, 0, 0, 0, jst), "A", "B", "C", "D", "E", "F", "G", "H", "I"),
+	NewRotation(time.Date(2026, 1, 23, 0, 0, 0, 0, jst), "A", "B", "C", "D", "E", "F", "G", "H", "I", "J"),
+}
+
+// エクストラはBW以降のカードを使用でき、マークのないBW・XY・SMのカードは拡張パックで判定する
+var expandedUnmarkedSeries = []string{"BW", "XY", "SM"}
+
+var formats = map[string]*Format{
+	FormatStandard: NewFormat(FormatStandard, "スタンダード", defaultRuleset, standardRotations...),
+	FormatExpanded: NewFormat(FormatExpanded, "エクストラ", defaultRuleset, expandedRotations...).
+		WithUnmarkedSeries(expandedUnmarkedSeries...),
+	// ローテーションを持たないフォーマットはすべてのカードを使用できる
+	FormatUnlimited: NewFormat(FormatUnlimited, "無制限", defaultRuleset),
+	// ハーフデッキはスタンダードのカードで30枚・同名2枚までで組む
+	FormatHalfDeck: NewFormat(FormatHalfDeck, "ハーフデッキ",
+		defaultRuleset.With(FormatHalfDeck, DeckSizeRule{Size: 30}, MaxCopiesRule{Limit: 2}),
+		standardRotations...,
+	),
+	// ジムリーダーチャレンジはエクストラのカードで1タイプ・同名1枚まで、ルールを持つポケモンは使えない
+	FormatGLC: NewFormat(FormatGLC, "ジムリーダーチャレンジ",
+		defaultRuleset.With(FormatGLC, MaxCopiesRule{Limit: 1}, SingleTypeRule{}, NoRuleBoxRule{}),
+		expandedRotations...,
+	).WithUnmarkedSeries(expandedUnmarkedSeries...),
+}
+
+func FindFormat(name string) (*Format, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, name)
+	}
+	return f, nil
+}
+
+func (f *Format) GetName() string {
+	return f.name
+}
+
+func (f *Format) GetDisplayName() string {
+	return f.displayName
+}
+
+func (f *Format) GetRuleset() *Ruleset {
+	return f.ruleset
+}
+
+// AllowedMarksAt 指定日時点で使用できるマークを返す。制限がない場合はfalseを返す
+func (f *Format) AllowedMarksAt(at time.Time) ([]string, bool) {
+	if len(f.rotations) == 0 {
+		return nil, false
+	}
+	// 最初のローテーションより前は最初のものを適用する
+	current := f.rotations[0]
+	for _, r := range f.rotations {
+		if r.effectiveFrom.After(at) {
+			break
+		}
+		current = r
+	}
+	return current.marks, true
+}
+
+func (f *Format) IsLegal(card domain.Card, at time.Time) bool {
+	// 基本エネルギーはマークに関係なく使用できる
+	if isBasicEnergy(card) {
+		return true
+	}
+	marks, restricted := f.AllowedMarksAt(at)
+	if !restricted {
+		return true
+	}
+	if card.GetRegulation() == "" {
+		return f.isUnmarkedSeries(card.GetExpansion())
+	}
+	return lo.Contains(marks, card.GetRegulation())
+}
+
+func (f *Format) isUnmarkedSeries(expansion string) bool {
+	return lo.SomeBy(f.unmarkedSeries, func(series string) bool {
+		return strings.HasPrefix(expansion, series)
+	})
+}
+
+// ValidateFormat フォーマットで使用できないカードをカードごとにエラーとして返す
+func (d *Deck) ValidateFormat(f *Format, at time.Time) []error {
+	var errors []error
+	for _, deckCard := range d.cards {
+		c := deckCard.card
+		if f.IsLegal(c, at) {
+			continue
+		}
+		errors = append(errors, newViolation(CodeIllegalRegulation, SeverityError, []CardRef{newCardRef(c, deckCard.quantity)}, map[string]any{
+			"format":      f.name,
+			"format_name": f.displayName,
+			"regulation":  c.GetRegulation(),
+		}))
+	}
+	return errors
+}
+
+func displayRegulation(mark string) string {
+	if mark == "" {
+		return "なし"
+	}
+	return mark
+}
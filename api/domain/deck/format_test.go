@@ -0,0 +1,211 @@
+package deck
+
+import (
+	"api/domain"
+	"api/domain/pokemon"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFindFormat(t *testing.T) {
+	if _, err := FindFormat(FormatStandard); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := FindFormat("invalid"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat, got %v", err)
+	}
+}
+
+func TestFindRuleset_Format(t *testing.T) {
+	for _, name := range []string{FormatStandard, FormatExpanded, FormatUnlimited, FormatHalfDeck, FormatGLC} {
+		f, err := FindFormat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := FindRuleset(name)
+		if err != nil {
+			t.Errorf("expected ruleset %s, got %v", name, err)
+			continue
+		}
+		if r != f.GetRuleset() {
+			t.Errorf("expected the %s format's ruleset, got %s", name, r.GetName())
+		}
+	}
+}
+
+func TestDeck_ValidateFormat(t *testing.T) {
+	oldPokemon, err := pokemon.NewPokemon(1, "ミュウV", pokemon.Psychic, 180, "", "", "image.png", "F", "S8", pokemon.Basic, "", nil, domain.SpecialRules{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	newPokemon, err := pokemon.NewPokemon(2, "ラルトス", pokemon.Psychic, 70, "", "", "image.png", "H", "SV5", pokemon.Basic, "", nil, domain.SpecialRules{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cards := []DeckCard{
+		*NewDeckCard(oldPokemon, 4),
+		*NewDeckCard(newPokemon, 4),
+		*NewDeckCard(newTestEnergy(t, 3, "基本超エネルギー"), 52),
+	}
+	d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, cards)
+
+	tests := map[string]struct {
+		format       string
+		at           time.Time
+		expectErrors int
+	}{
+		"standard before rotation": {
+			format:       FormatStandard,
+			at:           time.Date(2024, 6, 1, 0, 0, 0, 0, jst),
+			expectErrors: 0,
+		},
+		"standard after rotation": {
+			format:       FormatStandard,
+			at:           time.Date(2025, 1, 24, 0, 0, 0, 0, jst),
+			expectErrors: 1,
+		},
+		"expanded": {
+			format:       FormatExpanded,
+			at:           time.Date(2025, 6, 1, 0, 0, 0, 0, jst),
+			expectErrors: 0,
+		},
+		"unlimited": {
+			format:       FormatUnlimited,
+			at:           time.Date(2025, 6, 1, 0, 0, 0, 0, jst),
+			expectErrors: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := FindFormat(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if errs := d.ValidateFormat(f, tt.at); len(errs) != tt.expectErrors {
+				t.Errorf("expected %d errors, got %v", tt.expectErrors, errs)
+			}
+		})
+	}
+}
+
+func TestFormat_IsLegal_Unmarked(t *testing.T) {
+	at := time.Date(2025, 6, 1, 0, 0, 0, 0, jst)
+	newUnmarked := func(expansion string) domain.Card {
+		p, err := pokemon.NewPokemon(1, "ゲノセクトEX", pokemon.Grass, 180, "", "", "image.png", "", expansion, pokemon.Basic, "", nil, domain.SpecialRules{RuleBox: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	tests := map[string]struct {
+		format    string
+		expansion string
+		legal     bool
+	}{
+		"expanded allows BW":             {format: FormatExpanded, expansion: "BW8", legal: true},
+		"expanded allows XY":             {format: FormatExpanded, expansion: "XY1", legal: true},
+		"expanded allows SM promo":       {format: FormatExpanded, expansion: "SM-P", legal: true},
+		"expanded rejects older series":  {format: FormatExpanded, expansion: "DPt1", legal: false},
+		"expanded rejects unknown":       {format: FormatExpanded, expansion: "", legal: false},
+		"glc follows expanded":           {format: FormatGLC, expansion: "XY1", legal: true},
+		"standard rejects unmarked":      {format: FormatStandard, expansion: "SM1+", legal: false},
+		"unlimited allows every card":    {format: FormatUnlimited, expansion: "DPt1", legal: true},
+		"half deck follows the standard": {format: FormatHalfDeck, expansion: "BW8", legal: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := FindFormat(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if legal := f.IsLegal(newUnmarked(tt.expansion), at); legal != tt.legal {
+				t.Errorf("expected %v, got %v", tt.legal, legal)
+			}
+		})
+	}
+}
+
+func TestFormat_Ruleset(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	kirlia := newTestPokemon(t, 2, "キルリア", pokemon.Stage1, "ラルトス")
+	ultraBall := newTestTrainer(t, 4, "ハイパーボール")
+	psychicEnergy := newTestEnergy(t, 5, "基本超エネルギー")
+	gardevoirEx := newTestPokemon(t, 3, "サーナイトex", pokemon.Basic, "", domain.SpecialRules{RuleBox: true})
+	pikachu, err := pokemon.NewPokemon(6, "ピカチュウ", pokemon.Electric, 60, "", "", "image.png", "G", "SV1", pokemon.Basic, "", nil, domain.SpecialRules{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		format      string
+		cards       []DeckCard
+		expectCodes []ViolationCode
+	}{
+		"valid half deck": {
+			format: FormatHalfDeck,
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 2),
+				*NewDeckCard(kirlia, 2),
+				*NewDeckCard(ultraBall, 2),
+				*NewDeckCard(psychicEnergy, 24),
+			},
+		},
+		"half deck allows only two copies": {
+			format: FormatHalfDeck,
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 3),
+				*NewDeckCard(psychicEnergy, 27),
+			},
+			expectCodes: []ViolationCode{CodeMaxCopies},
+		},
+		"half deck with 60 cards": {
+			format: FormatHalfDeck,
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 2),
+				*NewDeckCard(psychicEnergy, 58),
+			},
+			expectCodes: []ViolationCode{CodeDeckSize},
+		},
+		"glc singleton": {
+			format: FormatGLC,
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 2),
+				*NewDeckCard(psychicEnergy, 58),
+			},
+			expectCodes: []ViolationCode{CodeMaxCopies},
+		},
+		"glc single type and rule box": {
+			format: FormatGLC,
+			cards: []DeckCard{
+				*NewDeckCard(ralts, 1),
+				*NewDeckCard(pikachu, 1),
+				*NewDeckCard(gardevoirEx, 1),
+				*NewDeckCard(psychicEnergy, 57),
+			},
+			expectCodes: []ViolationCode{CodeSingleType, CodeRuleBoxBanned},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := FindFormat(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := NewDeckWithoutValidation(0, "", "テストデッキ", "", f, nil, nil, tt.cards)
+			errs := d.Validate()
+			if len(errs) != len(tt.expectCodes) {
+				t.Fatalf("expected %v, got %v", tt.expectCodes, errs)
+			}
+			for i, err := range errs {
+				if code := err.(DeckValidationError).Code; code != tt.expectCodes[i] {
+					t.Errorf("expected %s, got %s", tt.expectCodes[i], code)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/samber/lo"
+)
+
+const (
+	OpeningHandSize = 7
+	PrizeCount      = 6
+)
+
+var ErrInvalidCardGroup = errors.New("invalid card group")
+
+// CardGroup 「ドローサポート」のように複数のカードをまとめて確率を求めるためのグループ
+type CardGroup struct {
+	Name      string
+	CardNames []string
+}
+
+// CardOdds カードまたはグループ単位の確率
+type CardOdds struct {
+	Name   string
+	Copies int
+	// 最初の手札7枚に1枚以上ある確率
+	Opening float64
+	// 指定したターンまでに指定した枚数以上引いている確率
+	ByTurn float64
+	// すべてサイドに落ちている確率
+	AllPrized float64
+}
+
+type DeckOdds struct {
+	DeckSize int
+	Turn     int
+	AtLeast  int
+	// たねポケモンが手札に1枚もなくマリガンになる確率
+	Mulligan float64
+	Cards    []CardOdds
+	Groups   []CardOdds
+}
+
+func NewCardGroup(name string, cardNames []string) CardGroup {
+	return CardGroup{
+		Name:      name,
+		CardNames: cardNames,
+	}
+}
+
+// CalculateOdds デッキの枚数から超幾何分布で正確な確率を求める
+// ターンNまでに見るカードは手札7枚とN回のドローで、サイドの6枚はその間の山札から取られるため、見るカードは山札から無作為に選んだ7+N枚と同じ分布になる
+func (d *Deck) CalculateOdds(turn int, atLeast int, groups []CardGroup) (*DeckOdds, error) {
+	deckSize := countQuantity(d.cards)
+	copiesByName := make(map[string]int)
+	var names []string
+	for _, deckCard := range d.cards {
+		name := deckCard.card.GetName()
+		if _, ok := copiesByName[name]; !ok {
+			names = append(names, name)
+		}
+		copiesByName[name] += deckCard.quantity
+	}
+
+	// 進化段階が分からないポケモンはたねとして数える。数えないと、ほとんどのデッキが必ずマリガンになる
+	basicCount := lo.SumBy(d.cards, func(deckCard DeckCard) int {
+		if p, ok := asPokemonCard(deckCard.card); ok && (p.IsBasic() || !p.IsStageKnown()) {
+			return deckCard.quantity
+		}
+		return 0
+	})
+
+	odds := &DeckOdds{
+		DeckSize: deckSize,
+		Turn:     turn,
+		AtLeast:  atLeast,
+		Mulligan: hypergeometricAtMost(deckSize, basicCount, OpeningHandSize, 0),
+	}
+
+	seen := OpeningHandSize + turn
+	newOdds := func(name string, copies int) CardOdds {
+		return CardOdds{
+			Name:      name,
+			Copies:    copies,
+			Opening:   1 - hypergeometricAtMost(deckSize, copies, OpeningHandSize, 0),
+			ByTurn:    1 - hypergeometricAtMost(deckSize, copies, seen, atLeast-1),
+			AllPrized: allPrized(deckSize, copies),
+		}
+	}
+
+	for _, name := range names {
+		odds.Cards = append(odds.Cards, newOdds(name, copiesByName[name]))
+	}
+
+	for _, group := range groups {
+		if group.Name == "" || len(group.CardNames) == 0 {
+			return nil, fmt.Errorf("%w: グループ名とカード名は必須です", ErrInvalidCardGroup)
+		}
+		copies := 0
+		for _, name := range lo.Uniq(group.CardNames) {
+			c, ok := copiesByName[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s はデッキに含まれていません", ErrInvalidCardGroup, name)
+			}
+			copies += c
+		}
+		odds.Groups = append(odds.Groups, newOdds(group.Name, copies))
+	}
+
+	return odds, nil
+}
+
+// hypergeometricAtMost 母集団populationのうちsuccesses枚の当たりがあるとき、draws枚引いて当たりがmaxHits枚以下である確率
+func hypergeometricAtMost(population int, successes int, draws int, maxHits int) float64 {
+	if draws > population {
+		draws = population
+	}
+	if maxHits < 0 {
+		return 0
+	}
+	total := binomial(population, draws)
+	if total.Sign() == 0 {
+		return 0
+	}
+	sum := new(big.Int)
+	for k := 0; k <= maxHits && k <= successes; k++ {
+		sum.Add(sum, new(big.Int).Mul(binomial(successes, k), binomial(population-successes, draws-k)))
+	}
+	p, _ := new(big.Rat).SetFrac(sum, total).Float64()
+	return p
+}
+
+// allPrized すべての枚数がサイド6枚に含まれる確率
+func allPrized(deckSize int, copies int) float64 {
+	if copies == 0 || copies > PrizeCount || deckSize < PrizeCount {
+		return 0
+	}
+	p, _ := new(big.Rat).SetFrac(binomial(deckSize-copies, PrizeCount-copies), binomial(deckSize, PrizeCount)).Float64()
+	return p
+}
+
+func binomial(n int, k int) *big.Int {
+	if k < 0 || n < 0 || k > n {
+		return new(big.Int)
+	}
+	return new(big.Int).Binomial(int64(n), int64(k))
+}
@@ -0,0 +1,63 @@
+package deck
+
+import (
+	"api/domain/pokemon"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDeck_CalculateOdds(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	mew := newTestPokemon(t, 2, "ミュウex", pokemon.Basic, "")
+	kirlia := newTestPokemon(t, 3, "キルリア", pokemon.Stage1, "ラルトス")
+	research := newTestTrainer(t, 4, "博士の研究")
+	iono := newTestTrainer(t, 5, "ナンジャモ")
+	psychicEnergy := newTestEnergy(t, 6, "基本超エネルギー")
+
+	d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(ralts, 4),
+		*NewDeckCard(mew, 1),
+		*NewDeckCard(newTestPokemon(t, 7, "ラルトス", pokemon.Basic, ""), 5),
+		*NewDeckCard(kirlia, 4),
+		*NewDeckCard(research, 4),
+		*NewDeckCard(iono, 2),
+		*NewDeckCard(psychicEnergy, 40),
+	})
+
+	odds, err := d.CalculateOdds(2, 2, []CardGroup{
+		NewCardGroup("ドローサポート", []string{"博士の研究", "ナンジャモ"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertOdds := func(name string, got float64, expected float64) {
+		t.Helper()
+		if math.Abs(got-expected) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+		}
+	}
+	// たねポケモン10枚
+	assertOdds("mulligan", odds.Mulligan, 0.2586292342975108)
+
+	cards := make(map[string]CardOdds)
+	for _, c := range odds.Cards {
+		cards[c.Name] = c
+	}
+	if cards["ラルトス"].Copies != 9 {
+		t.Errorf("expected prints of the same name to be combined, got %d", cards["ラルトス"].Copies)
+	}
+	assertOdds("opening", cards["博士の研究"].Opening, 0.3994996257446656)
+	assertOdds("by turn", cards["博士の研究"].ByTurn, 0.1031714294503061)
+	assertOdds("one copy prized", cards["ミュウex"].AllPrized, 0.1)
+	assertOdds("two copies prized", cards["ナンジャモ"].AllPrized, 0.00847457627118644)
+
+	if len(odds.Groups) != 1 || odds.Groups[0].Copies != 6 {
+		t.Errorf("unexpected groups: %+v", odds.Groups)
+	}
+
+	if _, err := d.CalculateOdds(2, 1, []CardGroup{NewCardGroup("不明", []string{"ピカチュウ"})}); !errors.Is(err, ErrInvalidCardGroup) {
+		t.Errorf("expected ErrInvalidCardGroup, got %v", err)
+	}
+}
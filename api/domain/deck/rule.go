@@ -0,0 +1,269 @@
+package deck
+
+import "github.com/samber/lo"
+
+// Rule デッキ構築のルール1つ分。Rulesetに組み合わせて使う
+type Rule interface {
+	Code() ViolationCode
+	Check(d *Deck) []error
+}
+
+// NameRequiredRule デッキ名は必須
+type NameRequiredRule struct{}
+
+func (NameRequiredRule) Code() ViolationCode {
+	return CodeNameRequired
+}
+
+func (r NameRequiredRule) Check(d *Deck) []error {
+	if d.name != "" {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, nil, nil)}
+}
+
+// DeckSizeRule デッキの合計枚数
+type DeckSizeRule struct {
+	Size int
+}
+
+func (DeckSizeRule) Code() ViolationCode {
+	return CodeDeckSize
+}
+
+func (r DeckSizeRule) Check(d *Deck) []error {
+	total := countQuantity(d.cards)
+	if total == r.Size {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, nil, map[string]any{
+		"expected": r.Size,
+		"actual":   total,
+	})}
+}
+
+// MaxCopiesRule 基本エネルギー以外の同名カードの上限
+type MaxCopiesRule struct {
+	Limit int
+}
+
+func (MaxCopiesRule) Code() ViolationCode {
+	return CodeMaxCopies
+}
+
+func (r MaxCopiesRule) Check(d *Deck) []error {
+	var errors []error
+	for _, sameNameCards := range groupByName(d.cards) {
+		if total := countQuantity(sameNameCards); total > r.Limit {
+			errors = append(errors, newViolation(r.Code(), SeverityError, toCardRefs(sameNameCards), map[string]any{
+				"limit":  r.Limit,
+				"actual": total,
+			}))
+		}
+	}
+	return errors
+}
+
+// PrismStarRule プリズムスターは同名カードを1枚まで
+type PrismStarRule struct{}
+
+func (PrismStarRule) Code() ViolationCode {
+	return CodePrismStarCopies
+}
+
+func (r PrismStarRule) Check(d *Deck) []error {
+	var errors []error
+	for _, sameNameCards := range groupByName(d.cards) {
+		isPrismStar := lo.ContainsBy(sameNameCards, func(deckCard DeckCard) bool {
+			return deckCard.card.IsPrismStar()
+		})
+		if total := countQuantity(sameNameCards); isPrismStar && total > 1 {
+			errors = append(errors, newViolation(r.Code(), SeverityError, toCardRefs(sameNameCards), map[string]any{
+				"limit":  1,
+				"actual": total,
+			}))
+		}
+	}
+	return errors
+}
+
+// AceSpecLimitRule エーススペックは名前に関係なくデッキ全体で1枚まで
+type AceSpecLimitRule struct{}
+
+func (AceSpecLimitRule) Code() ViolationCode {
+	return CodeAceSpecLimit
+}
+
+func (r AceSpecLimitRule) Check(d *Deck) []error {
+	aceSpecCards := lo.Filter(d.cards, func(deckCard DeckCard, _ int) bool {
+		return deckCard.IsAceSpec()
+	})
+	return checkDeckWideLimit(r.Code(), aceSpecCards, 1)
+}
+
+// RadiantLimitRule かがやくポケモンは名前に関係なくデッキ全体で1枚まで
+type RadiantLimitRule struct{}
+
+func (RadiantLimitRule) Code() ViolationCode {
+	return CodeRadiantLimit
+}
+
+func (r RadiantLimitRule) Check(d *Deck) []error {
+	radiantCards := lo.Filter(d.cards, func(deckCard DeckCard, _ int) bool {
+		return deckCard.card.IsRadiant()
+	})
+	return checkDeckWideLimit(r.Code(), radiantCards, 1)
+}
+
+// BasicPokemonRule たねポケモンがいないと対戦を始められない
+// 進化段階が分からないポケモンがいるときは、たねがいないとは言い切れないので判定しない
+type BasicPokemonRule struct{}
+
+func (BasicPokemonRule) Code() ViolationCode {
+	return CodeNoBasicPokemon
+}
+
+func (r BasicPokemonRule) Check(d *Deck) []error {
+	mayHaveBasicPokemon := lo.ContainsBy(d.cards, func(deckCard DeckCard) bool {
+		p, ok := asPokemonCard(deckCard.card)
+		return ok && (p.IsBasic() || !p.IsStageKnown())
+	})
+	if mayHaveBasicPokemon {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, nil, nil)}
+}
+
+// MainCardRule メインカードはデッキに含まれている必要がある
+type MainCardRule struct{}
+
+func (MainCardRule) Code() ViolationCode {
+	return CodeMainCardNotInDeck
+}
+
+func (r MainCardRule) Check(d *Deck) []error {
+	if d.mainCard == nil || d.mainCard.GetId() == 0 || d.contains(d.mainCard) {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, []CardRef{newCardRef(d.mainCard, 0)}, nil)}
+}
+
+// SubCardRule サブカードはデッキに含まれている必要がある
+type SubCardRule struct{}
+
+func (SubCardRule) Code() ViolationCode {
+	return CodeSubCardNotInDeck
+}
+
+func (r SubCardRule) Check(d *Deck) []error {
+	if d.subCard == nil || d.subCard.GetId() == 0 || d.contains(d.subCard) {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, []CardRef{newCardRef(d.subCard, 0)}, nil)}
+}
+
+// EvolutionRule 進化元がいない進化ポケモンは場に出せないため警告する
+type EvolutionRule struct{}
+
+func (EvolutionRule) Code() ViolationCode {
+	return CodeMissingEvolution
+}
+
+func (r EvolutionRule) Check(d *Deck) []error {
+	var warnings []error
+
+	pokemonNames := make(map[string]bool)
+	for _, deckCard := range d.cards {
+		if _, ok := asPokemonCard(deckCard.card); ok {
+			pokemonNames[deckCard.card.GetName()] = true
+		}
+	}
+
+	warned := make(map[string]bool)
+	for _, deckCard := range d.cards {
+		p, ok := asPokemonCard(deckCard.card)
+		if !ok || p.GetEvolvesFrom() == "" || warned[p.GetName()] {
+			continue
+		}
+		if !pokemonNames[p.GetEvolvesFrom()] {
+			warned[p.GetName()] = true
+			warnings = append(warnings, newViolation(r.Code(), SeverityWarning, []CardRef{newCardRef(p, deckCard.quantity)}, map[string]any{
+				"evolves_from": p.GetEvolvesFrom(),
+			}))
+		}
+	}
+
+	return warnings
+}
+
+// SingleTypeRule すべてのポケモンを同じタイプにそろえる
+type SingleTypeRule struct{}
+
+func (SingleTypeRule) Code() ViolationCode {
+	return CodeSingleType
+}
+
+func (r SingleTypeRule) Check(d *Deck) []error {
+	pokemonCards := lo.Filter(d.cards, func(deckCard DeckCard, _ int) bool {
+		_, ok := asPokemonCard(deckCard.card)
+		return ok
+	})
+	types := lo.Uniq(lo.Map(pokemonCards, func(deckCard DeckCard, _ int) string {
+		p, _ := asPokemonCard(deckCard.card)
+		return p.GetEnergyType()
+	}))
+	if len(types) <= 1 {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, toCardRefs(pokemonCards), map[string]any{
+		"types": types,
+	})}
+}
+
+// NoRuleBoxRule ルールを持つポケモンを禁止する
+type NoRuleBoxRule struct{}
+
+func (NoRuleBoxRule) Code() ViolationCode {
+	return CodeRuleBoxBanned
+}
+
+func (r NoRuleBoxRule) Check(d *Deck) []error {
+	ruleBoxCards := lo.Filter(d.cards, func(deckCard DeckCard, _ int) bool {
+		p, ok := asPokemonCard(deckCard.card)
+		return ok && p.HasRuleBox()
+	})
+	if len(ruleBoxCards) == 0 {
+		return nil
+	}
+	return []error{newViolation(r.Code(), SeverityError, toCardRefs(ruleBoxCards), nil)}
+}
+
+func checkDeckWideLimit(code ViolationCode, cards []DeckCard, limit int) []error {
+	total := countQuantity(cards)
+	if total <= limit {
+		return nil
+	}
+	return []error{newViolation(code, SeverityError, toCardRefs(cards), map[string]any{
+		"limit":  limit,
+		"actual": total,
+	})}
+}
+
+// groupByName 同名カードは版が違っても合算して数えるため、基本エネルギー以外を名前ごとにまとめる
+func groupByName(cards []DeckCard) [][]DeckCard {
+	var names []string
+	cardsByName := make(map[string][]DeckCard)
+	for _, deckCard := range cards {
+		if isBasicEnergy(deckCard.card) {
+			continue
+		}
+		name := deckCard.card.GetName()
+		if _, ok := cardsByName[name]; !ok {
+			names = append(names, name)
+		}
+		cardsByName[name] = append(cardsByName[name], deckCard)
+	}
+	return lo.Map(names, func(name string, _ int) []DeckCard {
+		return cardsByName[name]
+	})
+}
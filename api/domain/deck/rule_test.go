@@ -0,0 +1,165 @@
+package deck
+
+import (
+	"api/domain"
+	"api/domain/pokemon"
+	"errors"
+	"testing"
+)
+
+func TestRule_Check(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	kirlia := newTestPokemon(t, 2, "キルリア", pokemon.Stage1, "ラルトス")
+	ultraBall := newTestTrainer(t, 4, "ハイパーボール")
+	psychicEnergy := newTestEnergy(t, 5, "基本超エネルギー")
+	masterBall := newTestTrainer(t, 6, "マスターボール", domain.SpecialRules{AceSpec: true})
+	radiantGreninja := newTestPokemon(t, 8, "かがやくゲッコウガ", pokemon.Basic, "", domain.SpecialRules{Radiant: true})
+	prismStar := newTestTrainer(t, 10, "ネクロズマ◇", domain.SpecialRules{PrismStar: true})
+	unknownStage := newTestPokemon(t, 12, "サーナイト", pokemon.UnknownStage, "")
+
+	tests := map[string]struct {
+		rule       Rule
+		name       string
+		mainCard   domain.Card
+		cards      []DeckCard
+		expectHits int
+	}{
+		"name required": {
+			rule:       NameRequiredRule{},
+			name:       "",
+			expectHits: 1,
+		},
+		"deck size": {
+			rule:       DeckSizeRule{Size: 30},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(psychicEnergy, 30)},
+			expectHits: 0,
+		},
+		"max copies counts every print of the same name": {
+			rule: MaxCopiesRule{Limit: 4},
+			name: "テストデッキ",
+			cards: []DeckCard{
+				*NewDeckCard(ultraBall, 3),
+				*NewDeckCard(newTestTrainer(t, 11, "ハイパーボール"), 2),
+			},
+			expectHits: 1,
+		},
+		"max copies ignores basic energy": {
+			rule:       MaxCopiesRule{Limit: 4},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(psychicEnergy, 20)},
+			expectHits: 0,
+		},
+		"prism star": {
+			rule:       PrismStarRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(prismStar, 2)},
+			expectHits: 1,
+		},
+		"ace spec": {
+			rule:       AceSpecLimitRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(masterBall, 2)},
+			expectHits: 1,
+		},
+		"radiant": {
+			rule:       RadiantLimitRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(radiantGreninja, 1)},
+			expectHits: 0,
+		},
+		"basic pokemon": {
+			rule:       BasicPokemonRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(kirlia, 4)},
+			expectHits: 1,
+		},
+		"unknown stage may be basic": {
+			rule:       BasicPokemonRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(kirlia, 4), *NewDeckCard(unknownStage, 2)},
+			expectHits: 0,
+		},
+		"main card not in deck": {
+			rule:       MainCardRule{},
+			name:       "テストデッキ",
+			mainCard:   kirlia,
+			cards:      []DeckCard{*NewDeckCard(ralts, 4)},
+			expectHits: 1,
+		},
+		"evolution": {
+			rule:       EvolutionRule{},
+			name:       "テストデッキ",
+			cards:      []DeckCard{*NewDeckCard(ralts, 4), *NewDeckCard(kirlia, 4)},
+			expectHits: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := NewDeckWithoutValidation(0, "", tt.name, "", nil, tt.mainCard, nil, tt.cards)
+			hits := tt.rule.Check(d)
+			if len(hits) != tt.expectHits {
+				t.Fatalf("expected %d violations, got %v", tt.expectHits, hits)
+			}
+			for _, hit := range hits {
+				var violation DeckValidationError
+				if !errors.As(hit, &violation) || violation.Code != tt.rule.Code() {
+					t.Errorf("expected violation with code %s, got %v", tt.rule.Code(), hit)
+				}
+			}
+		})
+	}
+}
+
+// unregisterRuleset テストで登録したルールセットを取り除き、他のテストや-countでの再実行に影響させない
+func unregisterRuleset(name string) {
+	rulesetsMu.Lock()
+	defer rulesetsMu.Unlock()
+	delete(rulesets, name)
+}
+
+func TestRuleset(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	gardevoir := newTestPokemon(t, 3, "サーナイトex", pokemon.Stage2, "キルリア")
+	psychicEnergy := newTestEnergy(t, 5, "基本超エネルギー")
+	d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(ralts, 4),
+		*NewDeckCard(gardevoir, 2),
+		*NewDeckCard(psychicEnergy, 24),
+	})
+
+	standard, err := FindRuleset(DefaultRuleset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, warnings := standard.Check(d)
+	if len(errs) != 1 || len(warnings) != 1 {
+		t.Errorf("expected 1 error and 1 warning, got %v %v", errs, warnings)
+	}
+
+	halfDeck := standard.With("test_half", DeckSizeRule{Size: 30})
+	if err := RegisterRuleset(halfDeck); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unregisterRuleset(halfDeck.GetName()) })
+	if err := RegisterRuleset(halfDeck); !errors.Is(err, ErrDuplicateRuleset) {
+		t.Errorf("expected ErrDuplicateRuleset, got %v", err)
+	}
+	found, err := FindRuleset("test_half")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs, _ := found.Check(d); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	lenient := standard.Without("test_lenient", CodeDeckSize, CodeMissingEvolution)
+	if errs, warnings := lenient.Check(d); len(errs) != 0 || len(warnings) != 0 {
+		t.Errorf("expected no violations, got %v %v", errs, warnings)
+	}
+
+	if _, err := FindRuleset("invalid"); !errors.Is(err, ErrUnknownRuleset) {
+		t.Errorf("expected ErrUnknownRuleset, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+const DefaultRuleset = "default"
+
+var (
+	ErrUnknownRuleset   = errors.New("unknown ruleset")
+	ErrDuplicateRuleset = errors.New("ruleset already registered")
+)
+
+// Ruleset 名前付きのルールの組み合わせ。イベント独自のルールなどは既存のRulesetから派生させて登録する
+type Ruleset struct {
+	name  string
+	rules []Rule
+}
+
+func NewRuleset(name string, rules ...Rule) *Ruleset {
+	return &Ruleset{
+		name:  name,
+		rules: rules,
+	}
+}
+
+func (r *Ruleset) GetName() string {
+	return r.name
+}
+
+func (r *Ruleset) GetRules() []Rule {
+	return r.rules
+}
+
+// With ルールを追加した新しいRulesetを返す。同じコードのルールは置き換える
+func (r *Ruleset) With(name string, rules ...Rule) *Ruleset {
+	replaced := lo.Map(r.rules, func(rule Rule, _ int) Rule {
+		override, ok := lo.Find(rules, func(o Rule) bool {
+			return o.Code() == rule.Code()
+		})
+		if ok {
+			return override
+		}
+		return rule
+	})
+	added := lo.Filter(rules, func(o Rule, _ int) bool {
+		return !lo.ContainsBy(r.rules, func(rule Rule) bool {
+			return rule.Code() == o.Code()
+		})
+	})
+	return NewRuleset(name, append(replaced, added...)...)
+}
+
+// Without 指定したコードのルールを除いた新しいRulesetを返す
+func (r *Ruleset) Without(name string, codes ...ViolationCode) *Ruleset {
+	return NewRuleset(name, lo.Filter(r.rules, func(rule Rule, _ int) bool {
+		return !lo.Contains(codes, rule.Code())
+	})...)
+}
+
+// Check すべてのルールを実行し、違反を重大度ごとに分けて返す
+func (r *Ruleset) Check(d *Deck) (errs []error, warnings []error) {
+	for _, rule := range r.rules {
+		for _, err := range rule.Check(d) {
+			var violation DeckValidationError
+			if errors.As(err, &violation) && violation.Severity == SeverityWarning {
+				warnings = append(warnings, err)
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errs, warnings
+}
+
+var defaultRuleset = NewRuleset(DefaultRuleset,
+	NameRequiredRule{},
+	DeckSizeRule{Size: 60},
+	PrismStarRule{},
+	MaxCopiesRule{Limit: 4},
+	AceSpecLimitRule{},
+	RadiantLimitRule{},
+	BasicPokemonRule{},
+	MainCardRule{},
+	SubCardRule{},
+	EvolutionRule{},
+)
+
+var (
+	rulesetsMu sync.RWMutex
+	rulesets   = builtinRulesets()
+)
+
+// builtinRulesets 標準のルールセットに加え、各フォーマットの構築ルールをフォーマット名で登録する
+func builtinRulesets() map[string]*Ruleset {
+	registered := map[string]*Ruleset{
+		DefaultRuleset: defaultRuleset,
+	}
+	for name, f := range formats {
+		registered[name] = f.ruleset
+	}
+	return registered
+}
+
+// RegisterRuleset ハウスルールなどをドメインパッケージの外から追加する
+func RegisterRuleset(r *Ruleset) error {
+	rulesetsMu.Lock()
+	defer rulesetsMu.Unlock()
+	if _, ok := rulesets[r.name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateRuleset, r.name)
+	}
+	rulesets[r.name] = r
+	return nil
+}
+
+func FindRuleset(name string) (*Ruleset, error) {
+	rulesetsMu.RLock()
+	defer rulesetsMu.RUnlock()
+	r, ok := rulesets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRuleset, name)
+	}
+	return r, nil
+}
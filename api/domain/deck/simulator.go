@@ -0,0 +1,322 @@
+package deck
+
+import (
+	"api/domain/pokemon"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/samber/lo"
+)
+
+const (
+	MaxSimulationIterations = 100000
+	MaxSimulationTurns      = 10
+	// ふしぎなアメがあればたねポケモンから2進化ポケモンへ直接進化できる
+	RareCandyName = "ふしぎなアメ"
+)
+
+var ErrInvalidSimulationConfig = errors.New("invalid simulation config")
+
+// SearchEffect 手札に来たら使用して、山札から指定した名前のカードを手札に加えるカード
+type SearchEffect struct {
+	CardName string
+	// 先に書いたものから優先して、まだ手札に来ていないカードを探す
+	Targets []string
+	Count   int
+}
+
+// SimulationTarget すべてのカードが手札にそろう確率を調べたい組み合わせ
+type SimulationTarget struct {
+	Name      string
+	CardNames []string
+}
+
+type SimulationConfig struct {
+	Iterations int
+	Turns      int
+	Seed       uint64
+	Searches   []SearchEffect
+	Targets    []SimulationTarget
+}
+
+// SimulationRate ターンごとの達成率。ByTurn[0]が1ターン目
+type SimulationRate struct {
+	Name   string
+	ByTurn []float64
+}
+
+type SimulationResult struct {
+	Iterations int
+	Turns      int
+	Seed       uint64
+	// 1回以上マリガンした割合
+	MulliganRate     float64
+	AverageMulligans float64
+	// 進化ポケモンを場に出せている割合
+	EvolutionLines []SimulationRate
+	Targets        []SimulationRate
+}
+
+func NewSimulationConfig(iterations int, turns int, seed uint64, searches []SearchEffect, targets []SimulationTarget) SimulationConfig {
+	return SimulationConfig{
+		Iterations: iterations,
+		Turns:      turns,
+		Seed:       seed,
+		Searches:   searches,
+		Targets:    targets,
+	}
+}
+
+// evolutionLine 進化ポケモンとその進化元の名前
+type evolutionLine struct {
+	name      string
+	stage     string
+	chain     []string // たね、1進化、2進化の順
+	rareCandy bool     // ふしぎなアメでたねポケモンから直接進化できる
+}
+
+// Simulate 対戦相手のいない一人回しを繰り返し、序盤の安定度を求める。同じシードであれば結果は同じになる
+func (d *Deck) Simulate(config SimulationConfig) (*SimulationResult, error) {
+	if config.Iterations < 1 || config.Iterations > MaxSimulationIterations {
+		return nil, fmt.Errorf("%w: iterations は1から%dまでです", ErrInvalidSimulationConfig, MaxSimulationIterations)
+	}
+	if config.Turns < 1 || config.Turns > MaxSimulationTurns {
+		return nil, fmt.Errorf("%w: turns は1から%dまでです", ErrInvalidSimulationConfig, MaxSimulationTurns)
+	}
+
+	// カード名を番号に置き換えて山札を作る
+	var names []string
+	nameIndex := make(map[string]int)
+	var library []int
+	isBasic := make(map[int]bool)
+	for _, deckCard := range d.cards {
+		name := deckCard.card.GetName()
+		idx, ok := nameIndex[name]
+		if !ok {
+			idx = len(names)
+			nameIndex[name] = idx
+			names = append(names, name)
+		}
+		// 確率の計算と同じく、進化段階が分からないポケモンはたねとして扱う
+		if p, ok := asPokemonCard(deckCard.card); ok && (p.IsBasic() || !p.IsStageKnown()) {
+			isBasic[idx] = true
+		}
+		for i := 0; i < deckCard.quantity; i++ {
+			library = append(library, idx)
+		}
+	}
+	if len(library) < OpeningHandSize+PrizeCount {
+		return nil, fmt.Errorf("%w: デッキの枚数が足りません", ErrInvalidSimulationConfig)
+	}
+	if len(isBasic) == 0 {
+		return nil, fmt.Errorf("%w: たねポケモンがいないためマリガンが終わりません", ErrInvalidSimulationConfig)
+	}
+
+	searches := make(map[int]SearchEffect)
+	for _, s := range config.Searches {
+		idx, ok := nameIndex[s.CardName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s はデッキに含まれていません", ErrInvalidSimulationConfig, s.CardName)
+		}
+		if s.Count < 1 {
+			return nil, fmt.Errorf("%w: %s で手札に加える枚数は1以上です", ErrInvalidSimulationConfig, s.CardName)
+		}
+		for _, target := range s.Targets {
+			if _, ok := nameIndex[target]; !ok {
+				return nil, fmt.Errorf("%w: %s はデッキに含まれていません", ErrInvalidSimulationConfig, target)
+			}
+		}
+		searches[idx] = s
+	}
+	for _, target := range config.Targets {
+		for _, name := range target.CardNames {
+			if _, ok := nameIndex[name]; !ok {
+				return nil, fmt.Errorf("%w: %s はデッキに含まれていません", ErrInvalidSimulationConfig, name)
+			}
+		}
+	}
+
+	lines := d.evolutionLines()
+	_, hasRareCandy := nameIndex[RareCandyName]
+
+	r := rand.New(rand.NewPCG(config.Seed, config.Seed))
+	lineCounts := make([][]int, len(lines))
+	for i := range lineCounts {
+		lineCounts[i] = make([]int, config.Turns)
+	}
+	targetCounts := make([][]int, len(config.Targets))
+	for i := range targetCounts {
+		targetCounts[i] = make([]int, config.Turns)
+	}
+	gamesWithMulligan := 0
+	totalMulligans := 0
+
+	shuffled := make([]int, len(library))
+	for i := 0; i < config.Iterations; i++ {
+		// たねポケモンが手札に来るまで引き直す
+		mulligans := 0
+		for {
+			copy(shuffled, library)
+			r.Shuffle(len(shuffled), func(a, b int) {
+				shuffled[a], shuffled[b] = shuffled[b], shuffled[a]
+			})
+			if lo.ContainsBy(shuffled[:OpeningHandSize], func(idx int) bool { return isBasic[idx] }) {
+				break
+			}
+			mulligans++
+		}
+		if mulligans > 0 {
+			gamesWithMulligan++
+		}
+		totalMulligans += mulligans
+
+		firstSeen := playTurns(shuffled, config.Turns, searches, nameIndex)
+
+		seenBy := func(name string, turn int) bool {
+			idx, ok := nameIndex[name]
+			if !ok {
+				return false
+			}
+			t, ok := firstSeen[idx]
+			return ok && t <= turn
+		}
+		for turn := 1; turn <= config.Turns; turn++ {
+			for li, line := range lines {
+				if line.isOnline(turn, seenBy, hasRareCandy) {
+					lineCounts[li][turn-1]++
+				}
+			}
+			for ti, target := range config.Targets {
+				if lo.EveryBy(target.CardNames, func(name string) bool { return seenBy(name, turn) }) {
+					targetCounts[ti][turn-1]++
+				}
+			}
+		}
+	}
+
+	toRates := func(counts []int) []float64 {
+		return lo.Map(counts, func(c int, _ int) float64 {
+			return float64(c) / float64(config.Iterations)
+		})
+	}
+	result := &SimulationResult{
+		Iterations:       config.Iterations,
+		Turns:            config.Turns,
+		Seed:             config.Seed,
+		MulliganRate:     float64(gamesWithMulligan) / float64(config.Iterations),
+		AverageMulligans: float64(totalMulligans) / float64(config.Iterations),
+	}
+	for li, line := range lines {
+		result.EvolutionLines = append(result.EvolutionLines, SimulationRate{Name: line.name, ByTurn: toRates(lineCounts[li])})
+	}
+	for ti, target := range config.Targets {
+		result.Targets = append(result.Targets, SimulationRate{Name: target.Name, ByTurn: toRates(targetCounts[ti])})
+	}
+	return result, nil
+}
+
+// playTurns 手札7枚とサイド6枚を置いてから各ターン1枚引き、サーチ効果を使う。カードを最初に手札に加えたターンを返す(準備中は0)
+func playTurns(shuffled []int, turns int, searches map[int]SearchEffect, nameIndex map[string]int) map[int]int {
+	firstSeen := make(map[int]int, len(nameIndex))
+	// サイドに置いたカードは取り出せないため山札から除く
+	library := append([]int(nil), shuffled[OpeningHandSize+PrizeCount:]...)
+	var pending []int
+
+	addToHand := func(idx int, turn int) {
+		if _, ok := firstSeen[idx]; !ok {
+			firstSeen[idx] = turn
+		}
+		if _, ok := searches[idx]; ok {
+			pending = append(pending, idx)
+		}
+	}
+	for _, idx := range shuffled[:OpeningHandSize] {
+		addToHand(idx, 0)
+	}
+
+	for turn := 1; turn <= turns; turn++ {
+		if len(library) > 0 {
+			addToHand(library[0], turn)
+			library = library[1:]
+		}
+		// サーチで手札に来たカードがサーチ効果を持つ場合も続けて使う
+		for len(pending) > 0 {
+			search := searches[pending[0]]
+			pending = pending[1:]
+			found := 0
+			for _, target := range search.Targets {
+				if found >= search.Count {
+					break
+				}
+				targetIdx := nameIndex[target]
+				if _, ok := firstSeen[targetIdx]; ok {
+					continue
+				}
+				pos := lo.IndexOf(library, targetIdx)
+				if pos < 0 {
+					continue
+				}
+				library = append(library[:pos], library[pos+1:]...)
+				addToHand(targetIdx, turn)
+				found++
+			}
+		}
+	}
+	return firstSeen
+}
+
+// evolutionLines デッキ内の進化ポケモンごとに、進化元をたどれる進化ラインを作る
+func (d *Deck) evolutionLines() []evolutionLine {
+	evolvesFrom := make(map[string]string)
+	stages := make(map[string]string)
+	var evolved []string
+	for _, deckCard := range d.cards {
+		p, ok := asPokemonCard(deckCard.card)
+		if !ok || p.IsBasic() || !p.IsStageKnown() {
+			continue
+		}
+		if _, ok := stages[p.GetName()]; ok {
+			continue
+		}
+		stages[p.GetName()] = p.GetStage()
+		evolvesFrom[p.GetName()] = p.GetEvolvesFrom()
+		evolved = append(evolved, p.GetName())
+	}
+
+	var lines []evolutionLine
+	for _, name := range evolved {
+		chain := []string{name}
+		for current := name; evolvesFrom[current] != ""; current = evolvesFrom[current] {
+			chain = append([]string{evolvesFrom[current]}, chain...)
+			if len(chain) > 3 {
+				break
+			}
+		}
+		lines = append(lines, evolutionLine{
+			name:      name,
+			stage:     stages[name],
+			chain:     chain,
+			rareCandy: stages[name] == pokemon.Stage2 && len(chain) == 3,
+		})
+	}
+	return lines
+}
+
+// isOnline 出したターンには進化できないため、進化元を1ターンずつ前に手札に加えている必要がある
+func (l evolutionLine) isOnline(turn int, seenBy func(name string, turn int) bool, hasRareCandy bool) bool {
+	stepByStep := true
+	for i, name := range l.chain {
+		if !seenBy(name, turn-(len(l.chain)-1-i)) {
+			stepByStep = false
+			break
+		}
+	}
+	if stepByStep && turn >= len(l.chain) {
+		return true
+	}
+	if !l.rareCandy || !hasRareCandy || turn < 2 {
+		return false
+	}
+	return seenBy(l.chain[0], turn-1) && seenBy(RareCandyName, turn) && seenBy(l.name, turn)
+}
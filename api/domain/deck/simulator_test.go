@@ -0,0 +1,75 @@
+package deck
+
+import (
+	"api/domain/pokemon"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDeck_Simulate(t *testing.T) {
+	ralts := newTestPokemon(t, 1, "ラルトス", pokemon.Basic, "")
+	kirlia := newTestPokemon(t, 2, "キルリア", pokemon.Stage1, "ラルトス")
+	gardevoir := newTestPokemon(t, 3, "サーナイトex", pokemon.Stage2, "キルリア")
+	rareCandy := newTestTrainer(t, 4, RareCandyName)
+	ultraBall := newTestTrainer(t, 5, "ハイパーボール")
+	psychicEnergy := newTestEnergy(t, 6, "基本超エネルギー")
+
+	d := NewDeckWithoutValidation(0, "", "テストデッキ", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(ralts, 4),
+		*NewDeckCard(kirlia, 3),
+		*NewDeckCard(gardevoir, 3),
+		*NewDeckCard(rareCandy, 4),
+		*NewDeckCard(ultraBall, 4),
+		*NewDeckCard(psychicEnergy, 42),
+	})
+
+	config := NewSimulationConfig(2000, 3, 42, nil, []SimulationTarget{
+		{Name: "アメライン", CardNames: []string{"ラルトス", RareCandyName, "サーナイトex"}},
+	})
+	result, err := d.Simulate(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := d.Simulate(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, again) {
+		t.Error("expected the same result for the same seed")
+	}
+
+	if result.MulliganRate <= 0 || result.MulliganRate >= 1 {
+		t.Errorf("unexpected mulligan rate: %v", result.MulliganRate)
+	}
+	if len(result.EvolutionLines) != 2 {
+		t.Fatalf("expected lines for キルリア and サーナイトex, got %+v", result.EvolutionLines)
+	}
+	for _, line := range result.EvolutionLines {
+		// 最初のターンは進化できない
+		if line.ByTurn[0] != 0 {
+			t.Errorf("%s should not be online on turn 1: %v", line.Name, line.ByTurn)
+		}
+		if line.ByTurn[1] > line.ByTurn[2] {
+			t.Errorf("%s rate should not decrease: %v", line.Name, line.ByTurn)
+		}
+	}
+
+	// ハイパーボールでサーナイトexを探すと2ターン目に立つ割合が上がる
+	withSearch := config
+	withSearch.Searches = []SearchEffect{{CardName: "ハイパーボール", Targets: []string{"サーナイトex"}, Count: 1}}
+	searched, err := d.Simulate(withSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searched.EvolutionLines[1].ByTurn[1] <= result.EvolutionLines[1].ByTurn[1] {
+		t.Errorf("expected search to improve turn 2 rate: %v <= %v", searched.EvolutionLines[1].ByTurn, result.EvolutionLines[1].ByTurn)
+	}
+
+	invalid := config
+	invalid.Searches = []SearchEffect{{CardName: "ネストボール", Targets: []string{"ラルトス"}, Count: 1}}
+	if _, err := d.Simulate(invalid); !errors.Is(err, ErrInvalidSimulationConfig) {
+		t.Errorf("expected ErrInvalidSimulationConfig, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package deck
+
+import (
+	"api/domain"
+	"time"
+)
+
+// DeckVersion デッキを作成・更新するたびに保存されるスナップショットの情報
+type DeckVersion struct {
+	deckId    int
+	version   int
+	name      string
+	cardCount int
+	createdAt time.Time
+}
+
+func NewDeckVersion(deckId int, version int, name string, cardCount int, createdAt time.Time) *DeckVersion {
+	return &DeckVersion{
+		deckId:    deckId,
+		version:   version,
+		name:      name,
+		cardCount: cardCount,
+		createdAt: createdAt,
+	}
+}
+
+func (v *DeckVersion) GetDeckId() int {
+	return v.deckId
+}
+
+func (v *DeckVersion) GetVersion() int {
+	return v.version
+}
+
+func (v *DeckVersion) GetName() string {
+	return v.name
+}
+
+func (v *DeckVersion) GetCardCount() int {
+	return v.cardCount
+}
+
+func (v *DeckVersion) GetCreatedAt() time.Time {
+	return v.createdAt
+}
+
+// CardDiff 2つのデッキの間で枚数が変わったカード。追加されたカードはBeforeが0、外されたカードはAfterが0になる
+type CardDiff struct {
+	Card   domain.Card
+	Before int
+	After  int
+}
+
+type DeckDiff struct {
+	Added   []CardDiff
+	Removed []CardDiff
+	Changed []CardDiff
+}
+
+// Diff fromからtoへのカードの変更点を求める。追加・変更はtoの並び順、削除はfromの並び順になる
+func Diff(from *Deck, to *Deck) *DeckDiff {
+	diff := &DeckDiff{}
+	for _, after := range to.cards {
+		before := from.countCard(after.card)
+		switch {
+		case before == 0:
+			diff.Added = append(diff.Added, CardDiff{Card: after.card, After: after.quantity})
+		case before != after.quantity:
+			diff.Changed = append(diff.Changed, CardDiff{Card: after.card, Before: before, After: after.quantity})
+		}
+	}
+	for _, before := range from.cards {
+		if !to.contains(before.card) {
+			diff.Removed = append(diff.Removed, CardDiff{Card: before.card, Before: before.quantity})
+		}
+	}
+	return diff
+}
+
+// countCard 同じカードが複数行に分かれていても合計した枚数を返す
+func (d *Deck) countCard(card domain.Card) int {
+	total := 0
+	for _, c := range d.cards {
+		if isSameCard(c.card, card) {
+			total += c.quantity
+		}
+	}
+	return total
+}
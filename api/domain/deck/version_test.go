@@ -0,0 +1,59 @@
+package deck
+
+import (
+	"api/domain/pokemon"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	pikachu := newTestPokemon(t, 1, "ピカチュウex", pokemon.Basic, "")
+	raichu := newTestPokemon(t, 2, "ライチュウ", pokemon.Stage1, "ピカチュウ")
+	research := newTestTrainer(t, 3, "博士の研究")
+	nestBall := newTestTrainer(t, 4, "ネストボール")
+	energy := newTestEnergy(t, 5, "基本雷エネルギー")
+
+	from := NewDeckWithoutValidation(1, "", "v1", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(pikachu, 4),
+		*NewDeckCard(raichu, 2),
+		*NewDeckCard(research, 4),
+		*NewDeckCard(energy, 10),
+	})
+	to := NewDeckWithoutValidation(1, "", "v2", "", nil, nil, nil, []DeckCard{
+		*NewDeckCard(pikachu, 4),
+		*NewDeckCard(research, 3),
+		*NewDeckCard(nestBall, 4),
+		*NewDeckCard(energy, 9),
+	})
+
+	diff := Diff(from, to)
+
+	tests := []struct {
+		name  string
+		got   []CardDiff
+		names []string
+		want  [][2]int
+	}{
+		{"added", diff.Added, []string{"ネストボール"}, [][2]int{{0, 4}}},
+		{"removed", diff.Removed, []string{"ライチュウ"}, [][2]int{{2, 0}}},
+		{"changed", diff.Changed, []string{"博士の研究", "基本雷エネルギー"}, [][2]int{{4, 3}, {10, 9}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != len(tt.names) {
+				t.Fatalf("expected %d cards, got %+v", len(tt.names), tt.got)
+			}
+			for i, c := range tt.got {
+				if c.Card.GetName() != tt.names[i] {
+					t.Errorf("expected %s, got %s", tt.names[i], c.Card.GetName())
+				}
+				if c.Before != tt.want[i][0] || c.After != tt.want[i][1] {
+					t.Errorf("%s: expected %v, got %d -> %d", c.Card.GetName(), tt.want[i], c.Before, c.After)
+				}
+			}
+		})
+	}
+
+	if same := Diff(to, to); len(same.Added)+len(same.Removed)+len(same.Changed) != 0 {
+		t.Errorf("expected no diff for the same deck, got %+v", same)
+	}
+}
@@ -0,0 +1,133 @@
+package deck
+
+import (
+	"api/domain"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// ViolationCode クライアントが判定に使うためのルールごとの固定のコード
+type ViolationCode string
+
+const (
+	CodeNameRequired      ViolationCode = "name_required"
+	CodeDeckSize          ViolationCode = "deck_size"
+	CodeMaxCopies         ViolationCode = "max_copies"
+	CodePrismStarCopies   ViolationCode = "prism_star_copies"
+	CodeAceSpecLimit      ViolationCode = "ace_spec_limit"
+	CodeRadiantLimit      ViolationCode = "radiant_limit"
+	CodeNoBasicPokemon    ViolationCode = "no_basic_pokemon"
+	CodeMainCardNotInDeck ViolationCode = "main_card_not_in_deck"
+	CodeSubCardNotInDeck  ViolationCode = "sub_card_not_in_deck"
+	CodeMissingEvolution  ViolationCode = "missing_evolution"
+	CodeIllegalRegulation ViolationCode = "illegal_regulation"
+	CodeSingleType        ViolationCode = "single_type"
+	CodeRuleBoxBanned     ViolationCode = "rule_box_banned"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// CardRef 違反の原因となったカードとデッキに入っている枚数
+type CardRef struct {
+	Id       int
+	CardType int
+	Name     string
+	Quantity int
+}
+
+type DeckValidationError struct {
+	Code     ViolationCode
+	Severity Severity
+	Cards    []CardRef
+	Params   map[string]any
+}
+
+// メッセージはコード・カード・パラメータから組み立てるため、文言の変更がクライアントの判定に影響しない
+var violationMessages = map[ViolationCode]func(e DeckValidationError) string{
+	CodeNameRequired: func(e DeckValidationError) string {
+		return "デッキ名 は必須です"
+	},
+	CodeDeckSize: func(e DeckValidationError) string {
+		return fmt.Sprintf("カードの合計数は%v枚です", e.Params["expected"])
+	},
+	CodeMaxCopies: func(e DeckValidationError) string {
+		return fmt.Sprintf("%s が%v枚以上登録されています", e.cardNames(), e.Params["limit"].(int)+1)
+	},
+	CodePrismStarCopies: func(e DeckValidationError) string {
+		return fmt.Sprintf("プリズムスター: %s が%v枚以上登録されています", e.cardNames(), e.Params["limit"].(int)+1)
+	},
+	CodeAceSpecLimit: func(e DeckValidationError) string {
+		return fmt.Sprintf("エーススペックはデッキに%v枚までです: %s", e.Params["limit"], e.cardNames())
+	},
+	CodeRadiantLimit: func(e DeckValidationError) string {
+		return fmt.Sprintf("かがやくポケモンはデッキに%v枚までです: %s", e.Params["limit"], e.cardNames())
+	},
+	CodeNoBasicPokemon: func(e DeckValidationError) string {
+		return "たねポケモンが1枚も含まれていません"
+	},
+	CodeMainCardNotInDeck: func(e DeckValidationError) string {
+		return fmt.Sprintf("メインカード: %s がデッキに含まれていません", e.cardNames())
+	},
+	CodeSubCardNotInDeck: func(e DeckValidationError) string {
+		return fmt.Sprintf("サブカード: %s がデッキに含まれていません", e.cardNames())
+	},
+	CodeMissingEvolution: func(e DeckValidationError) string {
+		return fmt.Sprintf("%s の進化元 %s がデッキに含まれていません", e.cardNames(), e.Params["evolves_from"])
+	},
+	CodeIllegalRegulation: func(e DeckValidationError) string {
+		return fmt.Sprintf("%s (レギュレーション: %s) は%sでは使用できません", e.cardNames(), displayRegulation(fmt.Sprint(e.Params["regulation"])), e.Params["format_name"])
+	},
+	CodeSingleType: func(e DeckValidationError) string {
+		return fmt.Sprintf("ポケモンのタイプは1種類にそろえる必要があります: %s", strings.Join(e.Params["types"].([]string), ", "))
+	},
+	CodeRuleBoxBanned: func(e DeckValidationError) string {
+		return fmt.Sprintf("ルールを持つポケモンは使用できません: %s", e.cardNames())
+	},
+}
+
+func newViolation(code ViolationCode, severity Severity, cards []CardRef, params map[string]any) DeckValidationError {
+	return DeckValidationError{
+		Code:     code,
+		Severity: severity,
+		Cards:    cards,
+		Params:   params,
+	}
+}
+
+func (e DeckValidationError) Error() string {
+	render, ok := violationMessages[e.Code]
+	if !ok {
+		return string(e.Code)
+	}
+	return render(e)
+}
+
+// cardNames 同名カードが複数の版で入っている場合は1つにまとめて表示する
+func (e DeckValidationError) cardNames() string {
+	names := lo.Uniq(lo.Map(e.Cards, func(ref CardRef, _ int) string {
+		return ref.Name
+	}))
+	return strings.Join(names, ", ")
+}
+
+func newCardRef(card domain.Card, quantity int) CardRef {
+	return CardRef{
+		Id:       card.GetId(),
+		CardType: card.GetCardType(),
+		Name:     card.GetName(),
+		Quantity: quantity,
+	}
+}
+
+func toCardRefs(cards []DeckCard) []CardRef {
+	return lo.Map(cards, func(deckCard DeckCard, _ int) CardRef {
+		return newCardRef(deckCard.card, deckCard.quantity)
+	})
+}
@@ -3,24 +3,15 @@ package energy
 import (
 	"api/domain"
 	"errors"
-
-	"github.com/samber/lo"
 )
 
-// 2025/3/27現在エーススペックのエネルギーはこれらのみ
-var aceSpecEnergies = []string{
-	"レガシーエネルギー",
-	"リッチエネルギー",
-	"ネオアッパーエネルギー",
-}
-
 type Energy struct {
-	id         int
-	name       string
-	imageUrl   string
-	regulation string
-	expansion  string
-	acespec    bool
+	id           int
+	name         string
+	imageUrl     string
+	regulation   string
+	expansion    string
+	specialRules domain.SpecialRules
 }
 
 func NewEnergy(
@@ -29,8 +20,8 @@ func NewEnergy(
 	imageUrl string,
 	regulation string,
 	expansion string,
+	specialRules domain.SpecialRules,
 ) (*Energy, error) {
-	acespec := isAceSpec(name)
 	if id <= 0 {
 		return nil, errors.New("invalid id")
 	}
@@ -42,20 +33,20 @@ func NewEnergy(
 		return nil, errors.New("image url is required")
 	}
 
+	if specialRules.Radiant {
+		return nil, errors.New("only pokemon can be radiant")
+	}
+
 	return &Energy{
-		id:         id,
-		name:       name,
-		imageUrl:   imageUrl,
-		regulation: regulation,
-		expansion:  expansion,
-		acespec:    acespec,
+		id:           id,
+		name:         name,
+		imageUrl:     imageUrl,
+		regulation:   regulation,
+		expansion:    expansion,
+		specialRules: specialRules,
 	}, nil
 }
 
-func isAceSpec(name string) bool {
-	return lo.Contains(aceSpecEnergies, name)
-}
-
 func (e *Energy) GetId() int {
 	return e.id
 }
@@ -81,5 +72,13 @@ func (e *Energy) GetCardType() int {
 }
 
 func (e *Energy) IsAceSpec() bool {
-	return e.acespec
+	return e.specialRules.AceSpec
+}
+
+func (e *Energy) IsRadiant() bool {
+	return e.specialRules.Radiant
+}
+
+func (e *Energy) IsPrismStar() bool {
+	return e.specialRules.PrismStar
 }
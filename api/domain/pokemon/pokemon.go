@@ -17,8 +17,10 @@ type Pokemon struct {
 	abilityDescription string
 	regulation         string
 	expansion          string
+	stage              string
+	evolvesFrom        string
 	attacks            []PokemonAttack
-	acespec            bool
+	specialRules       domain.SpecialRules
 }
 
 type PokemonAttack struct {
@@ -43,6 +45,20 @@ const (
 
 var validEnergyTypes = []string{Fire, Water, Electric, Fight, Psychic, Grass, Steel, Dark, Colorless, Dragon}
 
+const (
+	Basic  = "たね"
+	Stage1 = "1進化"
+	Stage2 = "2進化"
+	VMAX   = "VMAX"
+	VSTAR  = "VSTAR"
+	VUnion = "V-UNION"
+	BREAK  = "BREAK"
+	// 進化段階を登録していないカード。たねかどうか分からない
+	UnknownStage = ""
+)
+
+var validStages = []string{Basic, Stage1, Stage2, VMAX, VSTAR, VUnion, BREAK}
+
 func NewPokemonAttack(name string, requiredEnergy string, damage string, description string) PokemonAttack {
 	return PokemonAttack{
 		name:           name,
@@ -52,10 +68,7 @@ func NewPokemonAttack(name string, requiredEnergy string, damage string, descrip
 	}
 }
 
-func NewPokemon(id int, name string, energyType string, hp int, ability string, abilityDescription string, imageUrl string, regulation string, expansion string, attacks []PokemonAttack, isAceSpec ...bool) (*Pokemon, error) {
-	// 今の所ポケモンにエーススペックはいない
-	acespec := false
-
+func NewPokemon(id int, name string, energyType string, hp int, ability string, abilityDescription string, imageUrl string, regulation string, expansion string, stage string, evolvesFrom string, attacks []PokemonAttack, specialRules domain.SpecialRules) (*Pokemon, error) {
 	if !isValidEnergyType(energyType) {
 		return nil, errors.New("energy type must be valid type")
 	}
@@ -64,6 +77,15 @@ func NewPokemon(id int, name string, energyType string, hp int, ability string,
 		return nil, errors.New("HP must be greater than or equal to 0")
 	}
 
+	if stage != UnknownStage && !isValidStage(stage) {
+		return nil, errors.New("stage must be valid stage")
+	}
+
+	// たねポケモンは何からも進化しない
+	if stage == Basic && evolvesFrom != "" {
+		return nil, errors.New("basic pokemon must not evolve from another pokemon")
+	}
+
 	return &Pokemon{
 		id:                 id,
 		name:               name,
@@ -74,8 +96,10 @@ func NewPokemon(id int, name string, energyType string, hp int, ability string,
 		imageUrl:           imageUrl,
 		regulation:         regulation,
 		expansion:          expansion,
+		stage:              stage,
+		evolvesFrom:        evolvesFrom,
 		attacks:            attacks,
-		acespec:            acespec,
+		specialRules:       specialRules,
 	}, nil
 }
 
@@ -83,6 +107,10 @@ func isValidEnergyType(energyType string) bool {
 	return lo.Contains(validEnergyTypes, energyType)
 }
 
+func isValidStage(stage string) bool {
+	return lo.Contains(validStages, stage)
+}
+
 func (p *Pokemon) GetId() int {
 	return p.id
 }
@@ -99,6 +127,46 @@ func (p *Pokemon) GetImageUrl() string {
 	return p.imageUrl
 }
 
+func (p *Pokemon) GetRegulation() string {
+	return p.regulation
+}
+
+func (p *Pokemon) GetExpansion() string {
+	return p.expansion
+}
+
 func (p *Pokemon) IsAceSpec() bool {
-	return p.acespec
+	return p.specialRules.AceSpec
+}
+
+func (p *Pokemon) IsRadiant() bool {
+	return p.specialRules.Radiant
+}
+
+func (p *Pokemon) IsPrismStar() bool {
+	return p.specialRules.PrismStar
+}
+
+func (p *Pokemon) GetStage() string {
+	return p.stage
+}
+
+func (p *Pokemon) GetEvolvesFrom() string {
+	return p.evolvesFrom
+}
+
+func (p *Pokemon) IsBasic() bool {
+	return p.stage == Basic
+}
+
+func (p *Pokemon) IsStageKnown() bool {
+	return p.stage != UnknownStage
+}
+
+func (p *Pokemon) GetEnergyType() string {
+	return p.energyType
+}
+
+func (p *Pokemon) HasRuleBox() bool {
+	return p.specialRules.RuleBox
 }
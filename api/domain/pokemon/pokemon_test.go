@@ -1,6 +1,7 @@
 package pokemon
 
 import (
+	"api/domain"
 	"testing"
 )
 
@@ -16,6 +17,8 @@ func TestNewPokemon(t *testing.T) {
 		imageUrl           string
 		regulation         string
 		expansion          string
+		stage              string
+		evolvesFrom        string
 		acespec            bool
 		attacks            []PokemonAttack
 		expectError        bool
@@ -31,6 +34,7 @@ func TestNewPokemon(t *testing.T) {
 			imageUrl:           "https://example.com/pikachu.png",
 			regulation:         "Regulation A",
 			expansion:          "Expansion 1",
+			stage:              Basic,
 			attacks:            []PokemonAttack{NewPokemonAttack("Thunder Shock", Electric, "30", "A jolt of electricity")},
 			expectError:        false,
 		},
@@ -45,6 +49,7 @@ func TestNewPokemon(t *testing.T) {
 			imageUrl:           "https://example.com/ralts.png",
 			regulation:         "Regulation B",
 			expansion:          "Expansion 2",
+			stage:              Basic,
 			attacks:            []PokemonAttack{NewPokemonAttack("Confusion", Psychic, "20", "Confuses the opponent")},
 			expectError:        true,
 		},
@@ -59,14 +64,63 @@ func TestNewPokemon(t *testing.T) {
 			imageUrl:           "https://example.com/hoge.png",
 			regulation:         "Regulation C",
 			expansion:          "Expansion 3",
+			stage:              Basic,
 			attacks:            []PokemonAttack{NewPokemonAttack("Ember", Fire, "40", "A small flame")},
 			expectError:        true,
 		},
+		"valid stage 2": {
+			id:          4,
+			name:        "サーナイトex",
+			energyType:  Psychic,
+			hp:          310,
+			imageUrl:    "https://example.com/gardevoir.png",
+			regulation:  "G",
+			expansion:   "SV1S",
+			stage:       Stage2,
+			evolvesFrom: "キルリア",
+			attacks:     []PokemonAttack{NewPokemonAttack("ミラクルフォース", Psychic+Colorless, "190", "")},
+			expectError: false,
+		},
+		"unknown stage": {
+			id:          7,
+			name:        "サーナイト",
+			energyType:  Psychic,
+			hp:          140,
+			imageUrl:    "https://example.com/gardevoir.png",
+			regulation:  "G",
+			expansion:   "SV1S",
+			stage:       UnknownStage,
+			expectError: false,
+		},
+		"invalid stage": {
+			id:          5,
+			name:        "キルリア",
+			energyType:  Psychic,
+			hp:          80,
+			imageUrl:    "https://example.com/kirlia.png",
+			regulation:  "G",
+			expansion:   "SV1S",
+			stage:       "3進化",
+			evolvesFrom: "ラルトス",
+			expectError: true,
+		},
+		"basic with evolves from": {
+			id:          6,
+			name:        "ラルトス",
+			energyType:  Psychic,
+			hp:          70,
+			imageUrl:    "https://example.com/ralts.png",
+			regulation:  "G",
+			expansion:   "SV1S",
+			stage:       Basic,
+			evolvesFrom: "キルリア",
+			expectError: true,
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			pokemon, err := NewPokemon(tt.id, tt.name, tt.energyType, tt.hp, tt.ability, tt.abilityDescription, tt.imageUrl, tt.regulation, tt.expansion, tt.attacks)
+			pokemon, err := NewPokemon(tt.id, tt.name, tt.energyType, tt.hp, tt.ability, tt.abilityDescription, tt.imageUrl, tt.regulation, tt.expansion, tt.stage, tt.evolvesFrom, tt.attacks, domain.SpecialRules{AceSpec: tt.acespec})
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("expected error for input %v, got nil", tt)
@@ -75,7 +129,7 @@ func TestNewPokemon(t *testing.T) {
 				if err != nil {
 					t.Errorf("unexpected error for input %v: %v", tt, err)
 				}
-				if pokemon.id != tt.id || pokemon.name != tt.name || pokemon.energyType != tt.energyType || pokemon.hp != tt.hp || pokemon.ability != tt.ability || pokemon.abilityDescription != tt.abilityDescription || pokemon.imageUrl != tt.imageUrl || pokemon.regulation != tt.regulation || pokemon.expansion != tt.expansion || len(pokemon.attacks) != len(tt.attacks) {
+				if pokemon.id != tt.id || pokemon.name != tt.name || pokemon.energyType != tt.energyType || pokemon.hp != tt.hp || pokemon.ability != tt.ability || pokemon.abilityDescription != tt.abilityDescription || pokemon.imageUrl != tt.imageUrl || pokemon.regulation != tt.regulation || pokemon.expansion != tt.expansion || pokemon.stage != tt.stage || pokemon.evolvesFrom != tt.evolvesFrom || len(pokemon.attacks) != len(tt.attacks) || pokemon.IsAceSpec() != tt.acespec {
 					t.Errorf("expected %v, got %v", tt, pokemon)
 				}
 			}
@@ -8,14 +8,14 @@ import (
 )
 
 type Trainer struct {
-	id          int
-	name        string
-	trainerType string
-	description string
-	imageUrl    string
-	regulation  string
-	expansion   string
-	acespec     bool
+	id           int
+	name         string
+	trainerType  string
+	description  string
+	imageUrl     string
+	regulation   string
+	expansion    string
+	specialRules domain.SpecialRules
 }
 
 const (
@@ -30,26 +30,25 @@ const (
 )
 
 var validTrainerTypes = []string{Supporter, Stadium, Item, PokemonsItem, THMachine, AceSpecItem, AceSpecPokemonsItem, AceSpecStadium}
-var aceSpecTrainerTypes = []string{AceSpecItem, AceSpecPokemonsItem, AceSpecStadium}
-
-func NewTrainer(id int, name string, trainerType string, description string, imageUrl string, regulation string, expansion string) (*Trainer, error) {
-	// エーススペックを確認
-	// TODO: テーブルにacespec カラム追加
-	acespec := isAceSpec(trainerType)
 
+func NewTrainer(id int, name string, trainerType string, description string, imageUrl string, regulation string, expansion string, specialRules domain.SpecialRules) (*Trainer, error) {
 	if !isValidTrainerType(trainerType) {
 		return nil, errors.New("Trainer type must be supporter, stadium or item")
 	}
 
+	if specialRules.Radiant {
+		return nil, errors.New("only pokemon can be radiant")
+	}
+
 	return &Trainer{
-		id:          id,
-		name:        name,
-		trainerType: trainerType,
-		description: description,
-		imageUrl:    imageUrl,
-		regulation:  regulation,
-		expansion:   expansion,
-		acespec:     acespec,
+		id:           id,
+		name:         name,
+		trainerType:  trainerType,
+		description:  description,
+		imageUrl:     imageUrl,
+		regulation:   regulation,
+		expansion:    expansion,
+		specialRules: specialRules,
 	}, nil
 }
 
@@ -57,10 +56,6 @@ func isValidTrainerType(trainerType string) bool {
 	return lo.Contains(validTrainerTypes, trainerType)
 }
 
-func isAceSpec(trainerType string) bool {
-	return lo.Contains(aceSpecTrainerTypes, trainerType)
-}
-
 func (t *Trainer) GetId() int {
 	return t.id
 }
@@ -77,6 +72,22 @@ func (t *Trainer) GetImageUrl() string {
 	return t.imageUrl
 }
 
+func (t *Trainer) GetRegulation() string {
+	return t.regulation
+}
+
+func (t *Trainer) GetExpansion() string {
+	return t.expansion
+}
+
 func (t *Trainer) IsAceSpec() bool {
-	return t.acespec
+	return t.specialRules.AceSpec
+}
+
+func (t *Trainer) IsRadiant() bool {
+	return t.specialRules.Radiant
+}
+
+func (t *Trainer) IsPrismStar() bool {
+	return t.specialRules.PrismStar
 }
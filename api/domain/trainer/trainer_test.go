@@ -1,19 +1,21 @@
 package trainer
 
 import (
+	"api/domain"
 	"testing"
 )
 
 func TestNewTrainer(t *testing.T) {
 	tests := map[string]struct {
-		id          int
-		name        string
-		trainerType string
-		description string
-		imageUrl    string
-		regulation  string
-		expansion   string
-		expectError bool
+		id           int
+		name         string
+		trainerType  string
+		description  string
+		imageUrl     string
+		regulation   string
+		expansion    string
+		specialRules domain.SpecialRules
+		expectError  bool
 	}{
 		"valid": {
 			id:          1,
@@ -35,11 +37,33 @@ func TestNewTrainer(t *testing.T) {
 			expansion:   "SV1",
 			expectError: true,
 		},
+		"valid ace spec": {
+			id:           3,
+			name:         "マスターボール",
+			trainerType:  AceSpecItem,
+			description:  "好きなポケモン1枚",
+			imageUrl:     "https://example.com/masterball.png",
+			regulation:   "G",
+			expansion:    "SV4K",
+			specialRules: domain.SpecialRules{AceSpec: true},
+			expectError:  false,
+		},
+		"radiant trainer": {
+			id:           4,
+			name:         "ネストボール",
+			trainerType:  Item,
+			description:  "ベンチにたねポケモン",
+			imageUrl:     "https://example.com/nestball.png",
+			regulation:   "G",
+			expansion:    "SV1",
+			specialRules: domain.SpecialRules{Radiant: true},
+			expectError:  true,
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			trainer, err := NewTrainer(tt.id, tt.name, tt.trainerType, tt.description, tt.imageUrl, tt.regulation, tt.expansion)
+			trainer, err := NewTrainer(tt.id, tt.name, tt.trainerType, tt.description, tt.imageUrl, tt.regulation, tt.expansion, tt.specialRules)
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("expected error for input %v, got nil", tt)
@@ -48,10 +72,21 @@ func TestNewTrainer(t *testing.T) {
 				if err != nil {
 					t.Errorf("unexpected error for input %v: %v", tt, err)
 				}
-				if trainer.id != tt.id || trainer.name != tt.name || trainer.trainerType != tt.trainerType || trainer.description != tt.description || trainer.imageUrl != tt.imageUrl {
+				if trainer.id != tt.id || trainer.name != tt.name || trainer.trainerType != tt.trainerType || trainer.description != tt.description || trainer.imageUrl != tt.imageUrl || trainer.IsAceSpec() != tt.specialRules.AceSpec {
 					t.Errorf("expected %v, got %v", tt, trainer)
 				}
 			}
 		})
 	}
 }
+
+func TestNewTrainer_AceSpecFromColumnOnly(t *testing.T) {
+	// エーススペックかどうかはace_spec列だけで決まり、トレーナーの種類からは判定しない
+	trainer, err := NewTrainer(3, "マスターボール", AceSpecItem, "好きなポケモン1枚", "https://example.com/masterball.png", "G", "SV4K", domain.SpecialRules{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trainer.IsAceSpec() {
+		t.Error("expected not ace spec")
+	}
+}
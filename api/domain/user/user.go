@@ -0,0 +1,52 @@
+package user
+
+import (
+	"errors"
+	"strings"
+)
+
+type User struct {
+	id    string
+	email string
+	name  string
+	// bcryptでハッシュ化したパスワード。平文は保存しない
+	passwordHash string
+}
+
+func NewUser(id string, email string, name string, passwordHash string) (*User, error) {
+	if id == "" {
+		return nil, errors.New("invalid id")
+	}
+	if !strings.Contains(email, "@") {
+		return nil, errors.New("invalid email")
+	}
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if passwordHash == "" {
+		return nil, errors.New("password hash is required")
+	}
+
+	return &User{
+		id:           id,
+		email:        email,
+		name:         name,
+		passwordHash: passwordHash,
+	}, nil
+}
+
+func (u *User) GetId() string {
+	return u.id
+}
+
+func (u *User) GetEmail() string {
+	return u.email
+}
+
+func (u *User) GetName() string {
+	return u.name
+}
+
+func (u *User) GetPasswordHash() string {
+	return u.passwordHash
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	"context"
+	"errors"
+)
+
+// 同じメールアドレスのユーザーが既に登録されている
+var ErrEmailAlreadyExists = errors.New("email already exists")
+
+type UserRepository interface {
+	// ユーザーの作成
+	Create(ctx context.Context, user *User) error
+
+	// メールアドレスからユーザーを取得
+	FindByEmail(ctx context.Context, email string) (*User, error)
+
+	// IDからユーザーを取得
+	FindById(ctx context.Context, id string) (*User, error)
+}
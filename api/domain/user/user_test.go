@@ -0,0 +1,57 @@
+package user
+
+import "testing"
+
+func TestNewUser(t *testing.T) {
+	tests := map[string]struct {
+		id           string
+		email        string
+		name         string
+		passwordHash string
+		expectError  bool
+	}{
+		"valid": {
+			id:           "0b0f2a4e-4b1f-4d43-9a36-3c5a7e1c2d10",
+			email:        "trainer@example.com",
+			name:         "サトシ",
+			passwordHash: "$2a$10$hash",
+		},
+		"missing id": {
+			email:        "trainer@example.com",
+			name:         "サトシ",
+			passwordHash: "$2a$10$hash",
+			expectError:  true,
+		},
+		"invalid email": {
+			id:           "0b0f2a4e-4b1f-4d43-9a36-3c5a7e1c2d10",
+			email:        "trainer",
+			name:         "サトシ",
+			passwordHash: "$2a$10$hash",
+			expectError:  true,
+		},
+		"missing password hash": {
+			id:          "0b0f2a4e-4b1f-4d43-9a36-3c5a7e1c2d10",
+			email:       "trainer@example.com",
+			name:        "サトシ",
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, err := NewUser(tt.id, tt.email, tt.name, tt.passwordHash)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error, got %+v", u)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if u.GetEmail() != tt.email || u.GetName() != tt.name {
+				t.Errorf("unexpected user: %+v", u)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"api/domain"
+	"api/domain/deck"
+	"api/pkg/lru"
+	"context"
+	"sync"
+)
+
+// CardRepository カード情報をメモリに保持するCardRepositoryのデコレーター
+// カードはインポートのとき以外は変わらないので、インポート後に Invalidate で捨てる
+type CardRepository struct {
+	next  deck.CardRepository
+	cache *lru.Cache[deck.CardKey, domain.Card]
+
+	// Invalidate のたびに増やす。読み込み中に捨てられたカードをキャッシュに戻さないために、読み込み前の値と比べる
+	mu         sync.Mutex
+	generation uint64
+}
+
+// NewCardRepository sizeは保持するカードの上限
+func NewCardRepository(next deck.CardRepository, size int) *CardRepository {
+	return &CardRepository{
+		next:  next,
+		cache: lru.New[deck.CardKey, domain.Card](size),
+	}
+}
+
+// カードIDとタイプからカード情報を取得
+func (r *CardRepository) FindCardById(ctx context.Context, cardId int, cardType domain.CardType) (domain.Card, error) {
+	key := deck.CardKey{Id: cardId, CardType: cardType}
+	if card, ok := r.cache.Get(key); ok {
+		return card, nil
+	}
+
+	// 見つからなかったカードはキャッシュしない
+	generation := r.currentGeneration()
+	card, err := r.next.FindCardById(ctx, cardId, cardType)
+	if err != nil {
+		return nil, err
+	}
+	r.addAll(generation, map[deck.CardKey]domain.Card{key: card})
+	return card, nil
+}
+
+// キャッシュにないカードだけをまとめて取得する
+func (r *CardRepository) FindCardsByIds(ctx context.Context, keys []deck.CardKey) (map[deck.CardKey]domain.Card, error) {
+	cards := make(map[deck.CardKey]domain.Card, len(keys))
+	var misses []deck.CardKey
+	seen := make(map[deck.CardKey]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if card, ok := r.cache.Get(key); ok {
+			cards[key] = card
+			continue
+		}
+		misses = append(misses, key)
+	}
+	if len(misses) == 0 {
+		return cards, nil
+	}
+
+	generation := r.currentGeneration()
+	found, err := r.next.FindCardsByIds(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	r.addAll(generation, found)
+	for key, card := range found {
+		cards[key] = card
+	}
+	return cards, nil
+}
+
+func (r *CardRepository) currentGeneration() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.generation
+}
+
+// addAll 読み込みを始めてから Invalidate されていなければ、読み込んだカードをキャッシュに入れる
+func (r *CardRepository) addAll(generation uint64, cards map[deck.CardKey]domain.Card) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.generation != generation {
+		return
+	}
+	for key, card := range cards {
+		r.cache.Add(key, card)
+	}
+}
+
+// Invalidate カードを再インポートしたときに、保持しているカードをすべて捨てる
+func (r *CardRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	r.cache.Purge()
+}
+
+func (r *CardRepository) Stats() lru.Stats {
+	return r.cache.Stats()
+}
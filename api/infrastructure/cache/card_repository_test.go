@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"api/domain"
+	"api/domain/deck"
+	domainErr "api/domain/error"
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubCard struct {
+	domain.Card
+	id int
+}
+
+func (c *stubCard) GetId() int {
+	return c.id
+}
+
+// 問い合わせの回数を数えるCardRepository
+type countingCardRepository struct {
+	calls int
+	keys  []deck.CardKey
+	// 問い合わせの途中で呼ぶ
+	during func()
+}
+
+func (r *countingCardRepository) FindCardById(ctx context.Context, cardId int, cardType domain.CardType) (domain.Card, error) {
+	r.calls++
+	if cardId == 0 {
+		return nil, domainErr.NotFoundErr
+	}
+	return &stubCard{id: cardId}, nil
+}
+
+func (r *countingCardRepository) FindCardsByIds(ctx context.Context, keys []deck.CardKey) (map[deck.CardKey]domain.Card, error) {
+	r.calls++
+	r.keys = keys
+	if r.during != nil {
+		r.during()
+	}
+	cards := map[deck.CardKey]domain.Card{}
+	for _, k := range keys {
+		if k.Id != 0 {
+			cards[k] = &stubCard{id: k.Id}
+		}
+	}
+	return cards, nil
+}
+
+func TestCardRepository_FindCardById(t *testing.T) {
+	next := &countingCardRepository{}
+	r := NewCardRepository(next, 10)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		card, err := r.FindCardById(ctx, 1, domain.Pokemon)
+		if err != nil || card.GetId() != 1 {
+			t.Fatalf("unexpected result: %v, %v", card, err)
+		}
+	}
+	if next.calls != 1 {
+		t.Errorf("expected 1 call to the underlying repository, got %d", next.calls)
+	}
+
+	// 見つからなかったカードはキャッシュしない
+	for i := 0; i < 2; i++ {
+		if _, err := r.FindCardById(ctx, 0, domain.Pokemon); !errors.Is(err, domainErr.NotFoundErr) {
+			t.Fatalf("expected NotFoundErr, got %v", err)
+		}
+	}
+	if next.calls != 3 {
+		t.Errorf("expected not found cards to be looked up every time, got %d calls", next.calls)
+	}
+
+	r.Invalidate()
+	if _, err := r.FindCardById(ctx, 1, domain.Pokemon); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 4 {
+		t.Errorf("expected a lookup after invalidation, got %d calls", next.calls)
+	}
+
+	stats := r.Stats()
+	if stats.Hits != 2 || stats.Misses != 4 || stats.Size != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestCardRepository_FindCardsByIds(t *testing.T) {
+	next := &countingCardRepository{}
+	r := NewCardRepository(next, 10)
+	ctx := context.Background()
+
+	if _, err := r.FindCardById(ctx, 1, domain.Pokemon); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	keys := []deck.CardKey{
+		{Id: 1, CardType: domain.Pokemon},
+		{Id: 2, CardType: domain.Trainer},
+		{Id: 2, CardType: domain.Trainer},
+		{Id: 3, CardType: domain.Energy},
+	}
+	cards, err := r.FindCardsByIds(ctx, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cards) != 3 {
+		t.Errorf("expected 3 cards, got %d", len(cards))
+	}
+	// キャッシュにないカードだけを重複なく1回で取得する
+	if next.calls != 2 || len(next.keys) != 2 {
+		t.Errorf("expected one batch for 2 missing cards, got %d calls with %v", next.calls, next.keys)
+	}
+
+	if _, err := r.FindCardsByIds(ctx, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 2 {
+		t.Errorf("expected all cards to be cached, got %d calls", next.calls)
+	}
+}
+
+func TestCardRepository_InvalidateDuringFill(t *testing.T) {
+	next := &countingCardRepository{}
+	r := NewCardRepository(next, 10)
+	ctx := context.Background()
+	keys := []deck.CardKey{{Id: 1, CardType: domain.Pokemon}}
+
+	// 読み込んでいる間にカードを再インポートした
+	next.during = r.Invalidate
+	if _, err := r.FindCardsByIds(ctx, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	next.during = nil
+
+	// 捨てる前に読んだカードはキャッシュに残さない
+	if stats := r.Stats(); stats.Size != 0 {
+		t.Errorf("expected the stale cards not to be cached, got %+v", stats)
+	}
+	if _, err := r.FindCardsByIds(ctx, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 2 {
+		t.Errorf("expected a lookup after invalidation, got %d calls", next.calls)
+	}
+	if _, err := r.FindCardsByIds(ctx, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 2 {
+		t.Errorf("expected the reloaded cards to be cached, got %d calls", next.calls)
+	}
+}
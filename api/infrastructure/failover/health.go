@@ -0,0 +1,53 @@
+package failover
+
+import (
+	"api/pkg/circuitbreaker"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// StartHealthProbe intervalごとにcheckを呼び、落ちていればブレーカーを開き、復旧していれば閉じる
+// 検索が来ていないときでも切り替えと復旧が進むようにするため。ctxが終わると止まる
+func StartHealthProbe(ctx context.Context, breaker *circuitbreaker.Breaker, interval time.Duration, check func(context.Context) error) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				probe(ctx, breaker, interval, check)
+			}
+		}
+	}()
+}
+
+func probe(ctx context.Context, breaker *circuitbreaker.Breaker, timeout time.Duration, check func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := check(ctx)
+	// サーバーを止めたときは状態を変えない
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	state := breaker.State()
+	if err != nil {
+		if state == circuitbreaker.Closed {
+			log.Printf("meilisearch health check failed, switching search to mysql: %v", err)
+		}
+		breaker.Trip()
+		return
+	}
+	// 閉じているときに戻すと、検索の連続失敗回数まで消してしまう
+	if state != circuitbreaker.Closed {
+		log.Printf("meilisearch is healthy again, switching search back")
+		breaker.Reset()
+	}
+}
@@ -0,0 +1,36 @@
+package failover
+
+import (
+	"api/application/search"
+	"api/pkg/circuitbreaker"
+	"context"
+	"fmt"
+)
+
+type rankedCardQueryService struct {
+	primary search.RankedCardQueryService
+	breaker *circuitbreaker.Breaker
+}
+
+// NewRankedCardQueryService MySQLではスコアで種類をまたいで並べられないので、代わりの検索はしない
+// ブレーカーは種類ごとの検索と共有し、Meilisearchが落ちている間はすぐにErrSearchUnavailableを返す
+func NewRankedCardQueryService(primary search.RankedCardQueryService, breaker *circuitbreaker.Breaker) search.RankedCardQueryService {
+	return &rankedCardQueryService{primary: primary, breaker: breaker}
+}
+
+func (s *rankedCardQueryService) SearchRankedCardList(ctx context.Context, query search.SearchRankedCardQuery) (*search.SearchRankedCardResult, error) {
+	if !s.breaker.Allow() {
+		return nil, fmt.Errorf("%w: meilisearch is down", search.ErrSearchUnavailable)
+	}
+	res, err := s.primary.SearchRankedCardList(ctx, query)
+	if err == nil {
+		s.breaker.Success()
+		return res, nil
+	}
+	if ctx.Err() != nil {
+		s.breaker.Cancel()
+		return nil, err
+	}
+	s.breaker.Failure()
+	return nil, fmt.Errorf("%w: %v", search.ErrSearchUnavailable, err)
+}
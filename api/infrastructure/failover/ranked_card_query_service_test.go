@@ -0,0 +1,39 @@
+package failover
+
+import (
+	"api/application/search"
+	"api/pkg/circuitbreaker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubRankedCardQueryService struct {
+	calls int
+	err   error
+}
+
+func (s *stubRankedCardQueryService) SearchRankedCardList(_ context.Context, _ search.SearchRankedCardQuery) (*search.SearchRankedCardResult, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &search.SearchRankedCardResult{}, nil
+}
+
+func TestRankedCardQueryService_UnavailableWhileBreakerIsOpen(t *testing.T) {
+	primary := &stubRankedCardQueryService{err: errors.New("connection refused")}
+	breaker := circuitbreaker.New(1, time.Minute)
+	s := NewRankedCardQueryService(primary, breaker)
+
+	_, err := s.SearchRankedCardList(context.Background(), search.SearchRankedCardQuery{})
+	assert.ErrorIs(t, err, search.ErrSearchUnavailable)
+	assert.Equal(t, circuitbreaker.Open, breaker.State())
+
+	_, err = s.SearchRankedCardList(context.Background(), search.SearchRankedCardQuery{})
+	assert.ErrorIs(t, err, search.ErrSearchUnavailable)
+	assert.Equal(t, 1, primary.calls)
+}
@@ -0,0 +1,99 @@
+// Package failover はMeilisearchが使えないときにMySQLで検索する
+//
+// Meilisearchへの検索が続けて失敗するか、ヘルスチェックで落ちていると分かったらサーキットブレーカーを開き、
+// 開いている間はMeilisearchを呼ばずにMySQLで検索する
+package failover
+
+import (
+	"api/application/search"
+	"api/application/search/energy"
+	"api/application/search/pokemon"
+	"api/application/search/trainer"
+	"api/pkg/circuitbreaker"
+	"context"
+	"fmt"
+	"log"
+)
+
+type pokemonQueryService struct {
+	primary  pokemon.PokemonQueryService
+	fallback pokemon.PokemonQueryService
+	breaker  *circuitbreaker.Breaker
+}
+
+// NewPokemonQueryService primaryはMeilisearch、fallbackはMySQLの実装
+func NewPokemonQueryService(primary, fallback pokemon.PokemonQueryService, breaker *circuitbreaker.Breaker) pokemon.PokemonQueryService {
+	return &pokemonQueryService{primary: primary, fallback: fallback, breaker: breaker}
+}
+
+func (s *pokemonQueryService) SearchPokemonList(ctx context.Context, query pokemon.SearchPokemonQuery) (*pokemon.SearchPokemonResult, error) {
+	return call(ctx, s.breaker, "pokemons", func(ctx context.Context) (*pokemon.SearchPokemonResult, error) {
+		return s.primary.SearchPokemonList(ctx, query)
+	}, func(ctx context.Context) (*pokemon.SearchPokemonResult, error) {
+		return s.fallback.SearchPokemonList(ctx, query)
+	})
+}
+
+type trainerQueryService struct {
+	primary  trainer.TrainerQueryService
+	fallback trainer.TrainerQueryService
+	breaker  *circuitbreaker.Breaker
+}
+
+func NewTrainerQueryService(primary, fallback trainer.TrainerQueryService, breaker *circuitbreaker.Breaker) trainer.TrainerQueryService {
+	return &trainerQueryService{primary: primary, fallback: fallback, breaker: breaker}
+}
+
+func (s *trainerQueryService) SearchTrainerList(ctx context.Context, query trainer.SearchTrainerQuery) (*trainer.SearchTrainerResult, error) {
+	return call(ctx, s.breaker, "trainers", func(ctx context.Context) (*trainer.SearchTrainerResult, error) {
+		return s.primary.SearchTrainerList(ctx, query)
+	}, func(ctx context.Context) (*trainer.SearchTrainerResult, error) {
+		return s.fallback.SearchTrainerList(ctx, query)
+	})
+}
+
+type energyQueryService struct {
+	primary  energy.EnergyQueryService
+	fallback energy.EnergyQueryService
+	breaker  *circuitbreaker.Breaker
+}
+
+func NewEnergyQueryService(primary, fallback energy.EnergyQueryService, breaker *circuitbreaker.Breaker) energy.EnergyQueryService {
+	return &energyQueryService{primary: primary, fallback: fallback, breaker: breaker}
+}
+
+func (s *energyQueryService) SearchEnergyList(ctx context.Context, query energy.SearchEnergyQuery) (*energy.SearchEnergyResult, error) {
+	return call(ctx, s.breaker, "energies", func(ctx context.Context) (*energy.SearchEnergyResult, error) {
+		return s.primary.SearchEnergyList(ctx, query)
+	}, func(ctx context.Context) (*energy.SearchEnergyResult, error) {
+		return s.fallback.SearchEnergyList(ctx, query)
+	})
+}
+
+// call ブレーカーが許可すればprimaryで検索し、失敗したらその場でfallbackで検索し直す
+func call[T any](ctx context.Context, breaker *circuitbreaker.Breaker, index string, primary, fallback func(context.Context) (T, error)) (T, error) {
+	if breaker.Allow() {
+		res, err := primary(ctx)
+		if err == nil {
+			breaker.Success()
+			search.RecordBackend(ctx, search.BackendMeilisearch)
+			return res, nil
+		}
+		// クライアントが切断しただけならMeilisearchの失敗として数えない
+		if ctx.Err() != nil {
+			breaker.Cancel()
+			var zero T
+			return zero, err
+		}
+		breaker.Failure()
+		log.Printf("meilisearch search for %s failed, falling back to mysql: %v", index, err)
+	}
+
+	res, err := fallback(ctx)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("%w: %v", search.ErrSearchUnavailable, err)
+	}
+	search.RecordBackend(ctx, search.BackendMySQL)
+	return res, nil
+}
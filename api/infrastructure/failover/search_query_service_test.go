@@ -0,0 +1,107 @@
+package failover
+
+import (
+	"api/application/search"
+	"api/application/search/facet"
+	"api/application/search/pokemon"
+	"api/pkg/circuitbreaker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var query = pokemon.SearchPokemonQuery{Q: "ピカチュウ"}
+
+type stubPokemonQueryService struct {
+	calls int
+	err   error
+	name  string
+}
+
+func (s *stubPokemonQueryService) SearchPokemonList(_ context.Context, _ pokemon.SearchPokemonQuery) (*pokemon.SearchPokemonResult, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pokemon.SearchPokemonResult{
+		Pokemons: []*pokemon.SearchPokemonList{{ID: 1, Name: s.name}},
+		Facets:   facet.Empty(),
+	}, nil
+}
+
+func TestPokemonQueryService_UsesPrimaryWhenHealthy(t *testing.T) {
+	primary := &stubPokemonQueryService{name: "meilisearch"}
+	fallback := &stubPokemonQueryService{name: "mysql"}
+	s := NewPokemonQueryService(primary, fallback, circuitbreaker.New(3, time.Minute))
+
+	ctx, backend := search.WithBackendRecorder(context.Background())
+	res, err := s.SearchPokemonList(ctx, query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "meilisearch", res.Pokemons[0].Name)
+	assert.Equal(t, 0, fallback.calls)
+	assert.Equal(t, search.BackendMeilisearch, backend())
+}
+
+func TestPokemonQueryService_FallsBackAndOpensBreaker(t *testing.T) {
+	primary := &stubPokemonQueryService{err: errors.New("connection refused")}
+	fallback := &stubPokemonQueryService{name: "mysql"}
+	breaker := circuitbreaker.New(2, time.Minute)
+	s := NewPokemonQueryService(primary, fallback, breaker)
+
+	for i := 0; i < 3; i++ {
+		ctx, backend := search.WithBackendRecorder(context.Background())
+		res, err := s.SearchPokemonList(ctx, query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "mysql", res.Pokemons[0].Name)
+		assert.Equal(t, search.BackendMySQL, backend())
+	}
+
+	// 2回失敗したらMeilisearchを呼ばなくなる
+	assert.Equal(t, 2, primary.calls)
+	assert.Equal(t, 3, fallback.calls)
+	assert.Equal(t, circuitbreaker.Open, breaker.State())
+}
+
+func TestPokemonQueryService_BothBackendsFail(t *testing.T) {
+	primary := &stubPokemonQueryService{err: errors.New("connection refused")}
+	fallback := &stubPokemonQueryService{err: errors.New("too many connections")}
+	s := NewPokemonQueryService(primary, fallback, circuitbreaker.New(3, time.Minute))
+
+	_, err := s.SearchPokemonList(context.Background(), query)
+
+	assert.ErrorIs(t, err, search.ErrSearchUnavailable)
+}
+
+func TestPokemonQueryService_CanceledRequestIsNotAFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	primary := &stubPokemonQueryService{err: context.Canceled}
+	fallback := &stubPokemonQueryService{name: "mysql"}
+	breaker := circuitbreaker.New(1, time.Minute)
+	s := NewPokemonQueryService(primary, fallback, breaker)
+
+	_, err := s.SearchPokemonList(ctx, query)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, fallback.calls)
+	assert.Equal(t, circuitbreaker.Closed, breaker.State())
+}
+
+func TestProbe(t *testing.T) {
+	breaker := circuitbreaker.New(3, time.Hour)
+
+	probe(context.Background(), breaker, time.Second, func(context.Context) error {
+		return errors.New("unavailable")
+	})
+	assert.Equal(t, circuitbreaker.Open, breaker.State())
+
+	probe(context.Background(), breaker, time.Second, func(context.Context) error {
+		return nil
+	})
+	assert.Equal(t, circuitbreaker.Closed, breaker.State())
+}
@@ -3,6 +3,7 @@ package queryservice
 import (
 	"api/application/search/deck"
 	"api/config"
+	"api/pkg/normalize"
 	"context"
 	"encoding/json"
 
@@ -24,6 +25,7 @@ type DeckResponse struct {
 	ID          int                `json:"id"`
 	Name        string             `json:"name"`
 	Description string             `json:"description"`
+	Format      string             `json:"format"`
 	MainCard    DeckCardResponse   `json:"main_card"`
 	SubCard     DeckCardResponse   `json:"sub_card"`
 	Cards       []DeckCardResponse `json:"cards"`
@@ -37,14 +39,15 @@ type DeckCardResponse struct {
 	ImageURL string `json:"image_url"`
 }
 
-func (d *deckQueryService) SearchDeckList(ctx context.Context, q string) ([]*deck.SearchDeckListDto, error) {
+func (d *deckQueryService) SearchDeckList(ctx context.Context, query deck.SearchDeckQuery) (*deck.SearchDeckResult, error) {
 	cnf := config.GetConfig()
 	msurl := cnf.MeiliConfig.Protocol + "://" + cnf.MeiliConfig.Host + ":" + cnf.MeiliConfig.Port
 	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
 	index := client.Index("decks")
-	searchRes, err := index.Search(q, &meilisearch.SearchRequest{
-		Limit: 10,
-		Sort:  []string{"id:desc"},
+	searchRes, err := index.SearchWithContext(ctx, normalize.String(query.Q), &meilisearch.SearchRequest{
+		Offset: int64(query.Page.Offset),
+		Limit:  int64(query.Page.Limit),
+		Sort:   sortRules(query.Page.Sort, false),
 	})
 	if err != nil {
 		return nil, err
@@ -63,6 +66,7 @@ func (d *deckQueryService) SearchDeckList(ctx context.Context, q string) ([]*dec
 			Id:          deckRes.ID,
 			Name:        deckRes.Name,
 			Description: deckRes.Description,
+			Format:      deckRes.Format,
 			MainCard: deck.SearchDeckCardDto{
 				Id:       deckRes.MainCard.ID,
 				Name:     deckRes.MainCard.Name,
@@ -89,5 +93,8 @@ func (d *deckQueryService) SearchDeckList(ctx context.Context, q string) ([]*dec
 		}
 	})
 
-	return deckList, nil
+	return &deck.SearchDeckResult{
+		Decks:              deckList,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
 }
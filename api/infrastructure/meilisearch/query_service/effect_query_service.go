@@ -0,0 +1,115 @@
+package queryservice
+
+import (
+	"api/application/search/effect"
+	"api/application/search/paging"
+	"api/config"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/samber/lo"
+)
+
+// EffectResponse pokemon_effectsインデックスのドキュメント。ワザと特性を1件ずつ登録している
+type EffectResponse struct {
+	ID             string  `json:"id"`
+	PokemonID      int     `json:"pokemon_id"`
+	PokemonName    string  `json:"pokemon_name"`
+	EnergyType     string  `json:"energy_type"`
+	ImageURL       string  `json:"image_url"`
+	Kind           string  `json:"kind"`
+	Name           string  `json:"name"`
+	RequiredEnergy string  `json:"required_energy"`
+	Damage         *string `json:"damage"`
+	Description    *string `json:"description"`
+	Formatted      struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"_formatted"`
+}
+
+// 説明文は一致した部分の前後だけを返す。日本語は単語の区切りが細かいので長めにしている
+const effectCropLength = 40
+
+type effectQueryService struct{}
+
+func NewEffectQueryService() *effectQueryService {
+	return &effectQueryService{}
+}
+
+func (s *effectQueryService) SearchEffectList(ctx context.Context, query effect.SearchEffectQuery) (*effect.SearchEffectResult, error) {
+	cnf := config.GetConfig()
+	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
+	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
+	index := client.Index("pokemon_effects")
+
+	var kinds []string
+	if query.Kind != "" {
+		kinds = []string{string(query.Kind)}
+	}
+	filter := (&filterBuilder{}).
+		in("kind", kinds).
+		between("damage_value", query.MinDamage, query.MaxDamage).
+		atMost("energy_count", query.MaxEnergy).
+		in("regulation", query.Regulations)
+
+	q := searchKeyword(query.Q, query.Lang)
+	searchRes, err := index.SearchWithContext(ctx, q, &meilisearch.SearchRequest{
+		Offset:                int64(query.Page.Offset),
+		Limit:                 int64(query.Page.Limit),
+		Sort:                  effectSortRules(query.Page.Sort),
+		Filter:                filter.build(),
+		AttributesToHighlight: []string{"name", "description"},
+		AttributesToCrop:      []string{"description"},
+		CropLength:            effectCropLength,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	effects := lo.FilterMap(searchRes.Hits, func(hit interface{}, _ int) (*effect.SearchEffectList, bool) {
+		var res EffectResponse
+		hitBytes, err := json.Marshal(hit)
+		if err != nil {
+			return nil, false
+		}
+		if err := json.Unmarshal(hitBytes, &res); err != nil {
+			return nil, false
+		}
+		return res.toEffect(), true
+	})
+
+	return &effect.SearchEffectResult{
+		Effects:            effects,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
+}
+
+func (r EffectResponse) toEffect() *effect.SearchEffectList {
+	return &effect.SearchEffectList{
+		PokemonID:              r.PokemonID,
+		PokemonName:            r.PokemonName,
+		EnergyType:             r.EnergyType,
+		ImageURL:               r.ImageURL,
+		Kind:                   effect.Kind(r.Kind),
+		Name:                   r.Name,
+		RequiredEnergy:         r.RequiredEnergy,
+		Damage:                 lo.FromPtr(r.Damage),
+		Description:            lo.FromPtr(r.Description),
+		HighlightedName:        r.Formatted.Name,
+		HighlightedDescription: r.Formatted.Description,
+	}
+}
+
+// effectSortRules ドキュメントのidは文字列なので、新しい順はポケモンのidで並べる
+func effectSortRules(key paging.SortKey) []string {
+	switch key {
+	case paging.SortByRelevance:
+		return nil
+	case paging.SortByName:
+		return []string{"name:asc", "pokemon_id:desc"}
+	}
+	return []string{"pokemon_id:desc"}
+}
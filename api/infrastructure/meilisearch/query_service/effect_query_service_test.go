@@ -0,0 +1,41 @@
+package queryservice
+
+import (
+	"api/application/search/effect"
+	"api/application/search/paging"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectResponse_ToEffect(t *testing.T) {
+	hit := `{
+		"id": "attack-12",
+		"pokemon_id": 3,
+		"pokemon_name": "ドラパルトex",
+		"kind": "attack",
+		"name": "ファントムダイブ",
+		"required_energy": "炎超",
+		"damage": "200",
+		"description": "相手のベンチポケモン全員に、ダメカンを6個好きなようにのせる。",
+		"_formatted": {
+			"name": "ファントムダイブ",
+			"description": "…ベンチポケモン全員に、<em>ダメカン</em>を6個好きなように…"
+		}
+	}`
+	var res EffectResponse
+	assert.NoError(t, json.Unmarshal([]byte(hit), &res))
+
+	got := res.toEffect()
+	assert.Equal(t, effect.KindAttack, got.Kind)
+	assert.Equal(t, 3, got.PokemonID)
+	assert.Equal(t, "200", got.Damage)
+	assert.Equal(t, "…ベンチポケモン全員に、<em>ダメカン</em>を6個好きなように…", got.HighlightedDescription)
+}
+
+func TestEffectSortRules(t *testing.T) {
+	assert.Nil(t, effectSortRules(paging.SortByRelevance))
+	assert.Equal(t, []string{"name:asc", "pokemon_id:desc"}, effectSortRules(paging.SortByName))
+	assert.Equal(t, []string{"pokemon_id:desc"}, effectSortRules(paging.SortByNewest))
+}
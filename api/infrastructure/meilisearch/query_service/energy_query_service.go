@@ -30,15 +30,18 @@ func NewEnergyQueryService() *energyQueryService {
 	return &energyQueryService{}
 }
 
-func (s *energyQueryService) SearchEnergyList(ctx context.Context, q string) ([]*energy.SearchEnergyList, error) {
+func (s *energyQueryService) SearchEnergyList(ctx context.Context, query energy.SearchEnergyQuery) (*energy.SearchEnergyResult, error) {
 	cnf := config.GetConfig()
 	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
 	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
 	index := client.Index("energies")
 
-	searchRes, err := index.Search(q, &meilisearch.SearchRequest{
-		Limit: 10,
-		Sort:  []string{"id:desc"},
+	searchRes, err := index.SearchWithContext(ctx, searchKeyword(query.Q, query.Lang), &meilisearch.SearchRequest{
+		Offset: int64(query.Page.Offset),
+		Limit:  int64(query.Page.Limit),
+		Sort:   sortRules(query.Page.Sort, false),
+		Filter: energyFilter(query).build(),
+		Facets: energyFacets,
 	})
 	if err != nil {
 		return nil, err
@@ -65,5 +68,21 @@ func (s *energyQueryService) SearchEnergyList(ctx context.Context, q string) ([]
 		return energy != nil
 	})
 
-	return energyList, nil
+	facets, err := toFacets(searchRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &energy.SearchEnergyResult{
+		Energies:           energyList,
+		Facets:             facets,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
+}
+
+func energyFilter(query energy.SearchEnergyQuery) *filterBuilder {
+	return (&filterBuilder{}).
+		in("regulation", query.Regulations).
+		in("expansion", query.Expansions).
+		is("ace_spec", query.AceSpec)
 }
@@ -0,0 +1,103 @@
+package queryservice
+
+import (
+	"api/application/search/facet"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// 絞り込みとファセットに使う属性。index-cardコマンドでfilterableAttributesに設定している
+var (
+	pokemonFacets = []string{"energy_type", "hp", "regulation", "expansion", "ace_spec", "has_ability"}
+	trainerFacets = []string{"trainer_type", "regulation", "expansion", "ace_spec"}
+	energyFacets  = []string{"regulation", "expansion", "ace_spec"}
+)
+
+// filterBuilder Meilisearchのfilter式を組み立てる。条件はすべてANDでつなぐ
+type filterBuilder struct {
+	conditions []string
+}
+
+// in 値が空のときは絞り込まない
+func (b *filterBuilder) in(attribute string, values []string) *filterBuilder {
+	if len(values) == 0 {
+		return b
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteFilterValue(v)
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf("%s IN [%s]", attribute, strings.Join(quoted, ", ")))
+	return b
+}
+
+// between 0のときはその側を絞り込まない
+func (b *filterBuilder) between(attribute string, min, max int) *filterBuilder {
+	if min > 0 {
+		b.conditions = append(b.conditions, fmt.Sprintf("%s >= %d", attribute, min))
+	}
+	if max > 0 {
+		b.conditions = append(b.conditions, fmt.Sprintf("%s <= %d", attribute, max))
+	}
+	return b
+}
+
+// atMost nilのときは絞り込まない。0以下を指定できるようにbetweenと分けている
+func (b *filterBuilder) atMost(attribute string, max *int) *filterBuilder {
+	if max == nil {
+		return b
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf("%s <= %d", attribute, *max))
+	return b
+}
+
+// is nilのときは絞り込まない
+func (b *filterBuilder) is(attribute string, value *bool) *filterBuilder {
+	if value == nil {
+		return b
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf("%s = %t", attribute, *value))
+	return b
+}
+
+// build 条件がなければnilを返す。空文字列のfilterはMeilisearchがエラーにする
+func (b *filterBuilder) build() interface{} {
+	if len(b.conditions) == 0 {
+		return nil
+	}
+	return strings.Join(b.conditions, " AND ")
+}
+
+var filterValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// quoteFilterValue ユーザーが入力した値で式が壊れないように、ダブルクォートで囲んでエスケープする
+func quoteFilterValue(v string) string {
+	return `"` + filterValueEscaper.Replace(v) + `"`
+}
+
+// toFacets レスポンスのfacetDistributionとfacetStatsを取り出す
+func toFacets(res *meilisearch.SearchResponse) (facet.Facets, error) {
+	facets := facet.Empty()
+	if res.FacetDistribution != nil {
+		b, err := json.Marshal(res.FacetDistribution)
+		if err != nil {
+			return facet.Facets{}, err
+		}
+		if err := json.Unmarshal(b, &facets.Distribution); err != nil {
+			return facet.Facets{}, err
+		}
+	}
+	if res.FacetStats != nil {
+		b, err := json.Marshal(res.FacetStats)
+		if err != nil {
+			return facet.Facets{}, err
+		}
+		if err := json.Unmarshal(b, &facets.Stats); err != nil {
+			return facet.Facets{}, err
+		}
+	}
+	return facets, nil
+}
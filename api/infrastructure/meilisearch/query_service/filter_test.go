@@ -0,0 +1,80 @@
+package queryservice
+
+import (
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterBuilder(t *testing.T) {
+	yes := true
+	no := false
+
+	tests := []struct {
+		name    string
+		builder *filterBuilder
+		want    interface{}
+	}{
+		{
+			name:    "no conditions",
+			builder: (&filterBuilder{}).in("energy_type", nil).between("hp", 0, 0).is("ace_spec", nil),
+			want:    nil,
+		},
+		{
+			name: "all conditions",
+			builder: (&filterBuilder{}).
+				in("energy_type", []string{"水", "草"}).
+				between("hp", 200, 0).
+				in("regulation", []string{"H"}).
+				is("ace_spec", &no).
+				is("has_ability", &yes),
+			want: `energy_type IN ["水", "草"] AND hp >= 200 AND regulation IN ["H"] AND ace_spec = false AND has_ability = true`,
+		},
+		{
+			name:    "hp range",
+			builder: (&filterBuilder{}).between("hp", 60, 120),
+			want:    `hp >= 60 AND hp <= 120`,
+		},
+		{
+			name:    "free attacks",
+			builder: (&filterBuilder{}).atMost("energy_count", new(int)).atMost("damage_value", nil),
+			want:    `energy_count <= 0`,
+		},
+		{
+			name:    "escapes quotes",
+			builder: (&filterBuilder{}).in("expansion", []string{`SV1" OR ace_spec = true OR "`, `a\b`}),
+			want:    `expansion IN ["SV1\" OR ace_spec = true OR \"", "a\\b"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.builder.build())
+		})
+	}
+}
+
+func TestToFacets(t *testing.T) {
+	res := &meilisearch.SearchResponse{
+		FacetDistribution: map[string]interface{}{
+			"energy_type": map[string]interface{}{"水": 12, "草": 3},
+		},
+		FacetStats: map[string]interface{}{
+			"hp": map[string]interface{}{"min": 30, "max": 340},
+		},
+	}
+
+	facets, err := toFacets(res)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), facets.Distribution["energy_type"]["水"])
+	assert.Equal(t, float64(340), facets.Stats["hp"].Max)
+
+	// ファセットを要求していない場合も空のmapを返す
+	facets, err = toFacets(&meilisearch.SearchResponse{})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, facets.Distribution)
+	assert.NotNil(t, facets.Stats)
+}
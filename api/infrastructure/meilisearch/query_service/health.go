@@ -0,0 +1,25 @@
+package queryservice
+
+import (
+	"api/config"
+	"context"
+	"fmt"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Healthy Meilisearchが検索を受け付けられる状態か確認する
+func Healthy(ctx context.Context) error {
+	cnf := config.GetConfig()
+	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
+	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
+
+	health, err := client.HealthWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if health.Status != "available" {
+		return fmt.Errorf("meilisearch status is %q", health.Status)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package queryservice
+
+import (
+	"api/application/translation"
+	"api/pkg/normalize"
+)
+
+// searchKeyword index-cardコマンドがnormalized_nameとnormalized_name_enに登録した形にそろえる
+// 英語で検索したときは英字をローマ字として読まない。入力途中の英語名がカナになって前方一致しなくなるため
+func searchKeyword(q string, lang translation.Lang) string {
+	if lang == translation.En {
+		return normalize.Latin(q)
+	}
+	return normalize.String(q)
+}
@@ -0,0 +1,16 @@
+package queryservice
+
+import (
+	"api/application/translation"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchKeyword(t *testing.T) {
+	assert.Equal(t, "ultra ba", searchKeyword("Ultra Ba", translation.En))
+	assert.Equal(t, "chari", searchKeyword("chari", translation.En))
+	assert.Equal(t, "ピカチユ", searchKeyword("pikachu", translation.Ja))
+	// 言語が分からないときは日本語として扱う
+	assert.Equal(t, "ピカチユ", searchKeyword("pikachu", ""))
+}
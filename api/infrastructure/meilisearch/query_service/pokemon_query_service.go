@@ -3,7 +3,6 @@ package queryservice
 import (
 	"api/application/search/pokemon"
 	"api/config"
-	"api/infrastructure/meilisearch/query_service/util"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,6 +25,8 @@ type PokemonResponse struct {
 	AbilityDescription *string         `json:"ability_description"`
 	Regulation         string          `json:"regulation"`
 	Expansion          string          `json:"expansion"`
+	Stage              string          `json:"stage"`
+	EvolvesFrom        *string         `json:"evolves_from"`
 	Attacks            []PokemonAttack `json:"attacks"`
 }
 
@@ -43,16 +44,19 @@ func NewPokemonQueryService() *pokemonQueryService {
 	return &pokemonQueryService{}
 }
 
-func (s *pokemonQueryService) SearchPokemonList(ctx context.Context, q string) ([]*pokemon.SearchPokemonList, error) {
+func (s *pokemonQueryService) SearchPokemonList(ctx context.Context, query pokemon.SearchPokemonQuery) (*pokemon.SearchPokemonResult, error) {
 	cnf := config.GetConfig()
 	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
 	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
 	index := client.Index("pokemons")
 
-	q = util.HiraganaToKatakana(q)
-	searchRes, err := index.Search(q, &meilisearch.SearchRequest{
-		Limit: 10,
-		Sort:  []string{"id:desc"},
+	q := searchKeyword(query.Q, query.Lang)
+	searchRes, err := index.SearchWithContext(ctx, q, &meilisearch.SearchRequest{
+		Offset: int64(query.Page.Offset),
+		Limit:  int64(query.Page.Limit),
+		Sort:   sortRules(query.Page.Sort, true),
+		Filter: pokemonFilter(query).build(),
+		Facets: pokemonFacets,
 	})
 	if err != nil {
 		return nil, err
@@ -70,5 +74,24 @@ func (s *pokemonQueryService) SearchPokemonList(ctx context.Context, q string) (
 		return &pokemon
 	})
 
-	return pokemonList, nil
+	facets, err := toFacets(searchRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pokemon.SearchPokemonResult{
+		Pokemons:           pokemonList,
+		Facets:             facets,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
+}
+
+func pokemonFilter(query pokemon.SearchPokemonQuery) *filterBuilder {
+	return (&filterBuilder{}).
+		in("energy_type", query.EnergyTypes).
+		between("hp", query.MinHp, query.MaxHp).
+		in("regulation", query.Regulations).
+		in("expansion", query.Expansions).
+		is("ace_spec", query.AceSpec).
+		is("has_ability", query.HasAbility)
 }
@@ -0,0 +1,117 @@
+package queryservice
+
+import (
+	"api/application/search"
+	"api/config"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/samber/lo"
+)
+
+// RankedCardResponse federated searchのヒット。どのインデックスのドキュメントかは_federationで分かる
+type RankedCardResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ImageURL    string `json:"image_url"`
+	EnergyType  string `json:"energy_type"`
+	HP          int    `json:"hp"`
+	TrainerType string `json:"trainer_type"`
+	Description string `json:"description"`
+	Federation  struct {
+		IndexUID             string  `json:"indexUid"`
+		WeightedRankingScore float64 `json:"weightedRankingScore"`
+	} `json:"_federation"`
+}
+
+var indexCardTypes = map[string]string{
+	"pokemons": "pokemon",
+	"trainers": "trainer",
+	"energies": "energy",
+}
+
+type rankedCardQueryService struct{}
+
+func NewRankedCardQueryService() *rankedCardQueryService {
+	return &rankedCardQueryService{}
+}
+
+// SearchRankedCardList 種類ごとの検索を1回のmulti-searchで送り、Meilisearchにランキングスコアの順で1つの一覧にまとめさせる
+func (s *rankedCardQueryService) SearchRankedCardList(ctx context.Context, query search.SearchRankedCardQuery) (*search.SearchRankedCardResult, error) {
+	cnf := config.GetConfig()
+	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
+	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
+
+	// federated searchではクエリごとのoffsetとlimitは指定できず、federationで全体の範囲を指定する
+	var queries []*meilisearch.SearchRequest
+	if query.Pokemon != nil {
+		queries = append(queries, &meilisearch.SearchRequest{
+			IndexUID: "pokemons",
+			Query:    searchKeyword(query.Pokemon.Q, query.Pokemon.Lang),
+			Filter:   pokemonFilter(*query.Pokemon).build(),
+		})
+	}
+	if query.Trainer != nil {
+		queries = append(queries, &meilisearch.SearchRequest{
+			IndexUID: "trainers",
+			Query:    searchKeyword(query.Trainer.Q, query.Trainer.Lang),
+			Filter:   trainerFilter(*query.Trainer).build(),
+		})
+	}
+	if query.Energy != nil {
+		queries = append(queries, &meilisearch.SearchRequest{
+			IndexUID: "energies",
+			Query:    searchKeyword(query.Energy.Q, query.Energy.Lang),
+			Filter:   energyFilter(*query.Energy).build(),
+		})
+	}
+
+	searchRes, err := client.MultiSearchWithContext(ctx, &meilisearch.MultiSearchRequest{
+		Federation: &meilisearch.MultiSearchFederation{
+			Offset: int64(query.Page.Offset),
+			Limit:  int64(query.Page.Limit),
+		},
+		Queries: queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cards := lo.FilterMap(searchRes.Hits, func(hit interface{}, _ int) (*search.RankedCard, bool) {
+		var res RankedCardResponse
+		hitBytes, err := json.Marshal(hit)
+		if err != nil {
+			return nil, false
+		}
+		if err := json.Unmarshal(hitBytes, &res); err != nil {
+			return nil, false
+		}
+		return res.toRankedCard()
+	})
+
+	return &search.SearchRankedCardResult{
+		Cards:              cards,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
+}
+
+// toRankedCard 検索していないインデックスのヒットは返さない
+func (r RankedCardResponse) toRankedCard() (*search.RankedCard, bool) {
+	cardType, ok := indexCardTypes[r.Federation.IndexUID]
+	if !ok {
+		return nil, false
+	}
+	return &search.RankedCard{
+		CardType:    cardType,
+		ID:          r.ID,
+		Name:        r.Name,
+		ImageURL:    r.ImageURL,
+		EnergyType:  r.EnergyType,
+		Hp:          r.HP,
+		TrainerType: r.TrainerType,
+		Description: r.Description,
+		Score:       r.Federation.WeightedRankingScore,
+	}, true
+}
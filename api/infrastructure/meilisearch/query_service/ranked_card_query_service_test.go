@@ -0,0 +1,32 @@
+package queryservice
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankedCardResponse_ToRankedCard(t *testing.T) {
+	hits := `[
+		{"id": 3, "name": "基本水エネルギー", "image_url": "e.png", "_federation": {"indexUid": "energies", "queriesPosition": 2, "weightedRankingScore": 1}},
+		{"id": 1, "name": "ゲッコウガex", "energy_type": "水", "hp": 310, "_federation": {"indexUid": "pokemons", "queriesPosition": 0, "weightedRankingScore": 0.75}},
+		{"id": 9, "name": "デッキ", "_federation": {"indexUid": "decks", "queriesPosition": 3, "weightedRankingScore": 0.5}}
+	]`
+	var res []RankedCardResponse
+	assert.NoError(t, json.Unmarshal([]byte(hits), &res))
+
+	energy, ok := res[0].toRankedCard()
+	assert.True(t, ok)
+	assert.Equal(t, "energy", energy.CardType)
+	assert.Equal(t, 1.0, energy.Score)
+
+	pokemon, ok := res[1].toRankedCard()
+	assert.True(t, ok)
+	assert.Equal(t, "pokemon", pokemon.CardType)
+	assert.Equal(t, 310, pokemon.Hp)
+	assert.Equal(t, 0.75, pokemon.Score)
+
+	_, ok = res[2].toRankedCard()
+	assert.False(t, ok)
+}
@@ -0,0 +1,20 @@
+package queryservice
+
+import "api/application/search/paging"
+
+// sortRules 並び順をMeilisearchのsortに変換する。同じ値のときは新しい順にする
+// hasHpがfalseのインデックスでは、HPの高い順は新しい順として扱う
+func sortRules(key paging.SortKey, hasHp bool) []string {
+	switch key {
+	case paging.SortByRelevance:
+		// sortを指定しなければ、Meilisearchのランキングルールの順になる
+		return nil
+	case paging.SortByName:
+		return []string{"name:asc", "id:desc"}
+	case paging.SortByHp:
+		if hasHp {
+			return []string{"hp:desc", "id:desc"}
+		}
+	}
+	return []string{"id:desc"}
+}
@@ -0,0 +1,17 @@
+package queryservice
+
+import (
+	"api/application/search/paging"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortRules(t *testing.T) {
+	assert.Nil(t, sortRules(paging.SortByRelevance, true))
+	assert.Equal(t, []string{"name:asc", "id:desc"}, sortRules(paging.SortByName, false))
+	assert.Equal(t, []string{"hp:desc", "id:desc"}, sortRules(paging.SortByHp, true))
+	// HPを持たないインデックスでは新しい順
+	assert.Equal(t, []string{"id:desc"}, sortRules(paging.SortByHp, false))
+	assert.Equal(t, []string{"id:desc"}, sortRules(paging.SortByNewest, true))
+}
@@ -31,15 +31,18 @@ func NewTrainerQueryService() *trainerQueryService {
 	return &trainerQueryService{}
 }
 
-func (s *trainerQueryService) SearchTrainerList(ctx context.Context, q string) ([]*trainer.SearchTrainerList, error) {
+func (s *trainerQueryService) SearchTrainerList(ctx context.Context, query trainer.SearchTrainerQuery) (*trainer.SearchTrainerResult, error) {
 	cnf := config.GetConfig()
 	msurl := fmt.Sprintf("%s://%s:%s", cnf.MeiliConfig.Protocol, cnf.MeiliConfig.Host, cnf.MeiliConfig.Port)
 	client := meilisearch.New(msurl, meilisearch.WithAPIKey(cnf.MeiliConfig.ApiKey))
 	index := client.Index("trainers")
 
-	searchRes, err := index.Search(q, &meilisearch.SearchRequest{
-		Limit: 10,
-		Sort:  []string{"id:desc"},
+	searchRes, err := index.SearchWithContext(ctx, searchKeyword(query.Q, query.Lang), &meilisearch.SearchRequest{
+		Offset: int64(query.Page.Offset),
+		Limit:  int64(query.Page.Limit),
+		Sort:   sortRules(query.Page.Sort, false),
+		Filter: trainerFilter(query).build(),
+		Facets: trainerFacets,
 	})
 	if err != nil {
 		return nil, err
@@ -66,5 +69,22 @@ func (s *trainerQueryService) SearchTrainerList(ctx context.Context, q string) (
 		return trainer != nil
 	})
 
-	return trainerList, nil
+	facets, err := toFacets(searchRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trainer.SearchTrainerResult{
+		Trainers:           trainerList,
+		Facets:             facets,
+		EstimatedTotalHits: searchRes.EstimatedTotalHits,
+	}, nil
+}
+
+func trainerFilter(query trainer.SearchTrainerQuery) *filterBuilder {
+	return (&filterBuilder{}).
+		in("trainer_type", query.TrainerTypes).
+		in("regulation", query.Regulations).
+		in("expansion", query.Expansions).
+		is("ace_spec", query.AceSpec)
 }
@@ -8,6 +8,8 @@ package dbgen
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 )
 
 const createDeck = `-- name: CreateDeck :execresult
@@ -17,9 +19,11 @@ INSERT INTO decks (
   main_card_id,
   main_card_type_id,
   sub_card_id,
-  sub_card_type_id
+  sub_card_type_id,
+  format,
+  owner_id
 ) VALUES (
-  ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?
 )
 `
 
@@ -30,6 +34,8 @@ type CreateDeckParams struct {
 	MainCardTypeID sql.NullInt64  `json:"main_card_type_id"`
 	SubCardID      sql.NullInt64  `json:"sub_card_id"`
 	SubCardTypeID  sql.NullInt64  `json:"sub_card_type_id"`
+	Format         string         `json:"format"`
+	OwnerID        sql.NullString `json:"owner_id"`
 }
 
 func (q *Queries) CreateDeck(ctx context.Context, arg CreateDeckParams) (sql.Result, error) {
@@ -40,6 +46,8 @@ func (q *Queries) CreateDeck(ctx context.Context, arg CreateDeckParams) (sql.Res
 		arg.MainCardTypeID,
 		arg.SubCardID,
 		arg.SubCardTypeID,
+		arg.Format,
+		arg.OwnerID,
 	)
 }
 
@@ -90,46 +98,8 @@ func (q *Queries) DeleteDeckCardsByDeckId(ctx context.Context, deckID int64) err
 	return err
 }
 
-const findALl = `-- name: FindALl :many
-SELECT id, name, description, main_card_id, main_card_type_id, sub_card_id, sub_card_type_id, created_at, updated_at FROM decks
-ORDER BY id DESC
-`
-
-func (q *Queries) FindALl(ctx context.Context) ([]Deck, error) {
-	rows, err := q.db.QueryContext(ctx, findALl)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Deck{}
-	for rows.Next() {
-		var i Deck
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.MainCardID,
-			&i.MainCardTypeID,
-			&i.SubCardID,
-			&i.SubCardTypeID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const findDeckById = `-- name: FindDeckById :one
-SELECT id, name, description, main_card_id, main_card_type_id, sub_card_id, sub_card_type_id, created_at, updated_at FROM decks
+SELECT id, name, description, main_card_id, main_card_type_id, sub_card_id, sub_card_type_id, format, owner_id, created_at, updated_at FROM decks
 WHERE id = ?
 LIMIT 1
 `
@@ -145,6 +115,8 @@ func (q *Queries) FindDeckById(ctx context.Context, id int64) (Deck, error) {
 		&i.MainCardTypeID,
 		&i.SubCardID,
 		&i.SubCardTypeID,
+		&i.Format,
+		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -187,6 +159,181 @@ func (q *Queries) FindDeckCardsByDeckId(ctx context.Context, deckID int64) ([]De
 	return items, nil
 }
 
+const findDeckCardsByDeckIds = `-- name: FindDeckCardsByDeckIds :many
+SELECT id, deck_id, card_id, card_type_id, quantity, created_at, updated_at FROM deck_cards
+WHERE deck_id IN (/*SLICE:deck_ids*/?)
+ORDER BY deck_id, id
+`
+
+func (q *Queries) FindDeckCardsByDeckIds(ctx context.Context, deckIds []int64) ([]DeckCard, error) {
+	query := findDeckCardsByDeckIds
+	var queryParams []interface{}
+	if len(deckIds) > 0 {
+		for _, v := range deckIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:deck_ids*/?", strings.Repeat(",?", len(deckIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:deck_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DeckCard{}
+	for rows.Next() {
+		var i DeckCard
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeckID,
+			&i.CardID,
+			&i.CardTypeID,
+			&i.Quantity,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findDeckPage = `-- name: FindDeckPage :many
+SELECT
+  id, name, description, main_card_id, main_card_type_id, sub_card_id, sub_card_type_id, format, owner_id, created_at, updated_at, card_count, sort_value
+FROM (
+  SELECT
+    d.id, d.name, d.description, d.main_card_id, d.main_card_type_id, d.sub_card_id, d.sub_card_type_id, d.format, d.owner_id, d.created_at, d.updated_at,
+    (SELECT CAST(COALESCE(SUM(dc.quantity), 0) AS SIGNED) FROM deck_cards dc WHERE dc.deck_id = d.id) AS card_count,
+    CAST(CASE ?
+      WHEN 'name' THEN d.name
+      WHEN 'updated_at' THEN DATE_FORMAT(d.updated_at, '%Y-%m-%d %H:%i:%s')
+      ELSE DATE_FORMAT(d.created_at, '%Y-%m-%d %H:%i:%s')
+    END AS CHAR) AS sort_value
+  FROM decks d
+  WHERE (d.owner_id = ? OR (? AND d.owner_id IS NULL))
+    AND (? = '' OR d.name LIKE CONCAT('%', ?, '%'))
+    AND (? = '' OR d.format = ?)
+    AND (? = 0 OR (d.main_card_id = ? AND d.main_card_type_id = ?))
+    AND (? = 0 OR EXISTS (
+      SELECT 1 FROM deck_cards dc
+      WHERE dc.deck_id = d.id AND dc.card_id = ? AND dc.card_type_id = ?
+    ))
+) AS page
+WHERE ? = 0
+  OR (? AND (sort_value < ? OR (sort_value = ? AND id < ?)))
+  OR (NOT ? AND (sort_value > ? OR (sort_value = ? AND id > ?)))
+ORDER BY
+  CASE WHEN ? THEN sort_value END DESC,
+  CASE WHEN ? THEN id END DESC,
+  sort_value ASC,
+  id ASC
+LIMIT ?
+`
+
+type FindDeckPageParams struct {
+	SortKey        string         `json:"sort_key"`
+	OwnerID        sql.NullString `json:"owner_id"`
+	IncludeUnowned bool           `json:"include_unowned"`
+	Name           string         `json:"name"`
+	Format         string         `json:"format"`
+	MainCardID     int64          `json:"main_card_id"`
+	MainCardTypeID int64          `json:"main_card_type_id"`
+	CardID         int64          `json:"card_id"`
+	CardTypeID     int64          `json:"card_type_id"`
+	CursorID       int64          `json:"cursor_id"`
+	Descending     bool           `json:"descending"`
+	CursorValue    string         `json:"cursor_value"`
+	Limit          int32          `json:"limit"`
+}
+
+type FindDeckPageRow struct {
+	ID             int64          `json:"id"`
+	Name           string         `json:"name"`
+	Description    sql.NullString `json:"description"`
+	MainCardID     sql.NullInt64  `json:"main_card_id"`
+	MainCardTypeID sql.NullInt64  `json:"main_card_type_id"`
+	SubCardID      sql.NullInt64  `json:"sub_card_id"`
+	SubCardTypeID  sql.NullInt64  `json:"sub_card_type_id"`
+	Format         string         `json:"format"`
+	OwnerID        sql.NullString `json:"owner_id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	CardCount      int64          `json:"card_count"`
+	SortValue      string         `json:"sort_value"`
+}
+
+func (q *Queries) FindDeckPage(ctx context.Context, arg FindDeckPageParams) ([]FindDeckPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, findDeckPage,
+		arg.SortKey,
+		arg.OwnerID,
+		arg.IncludeUnowned,
+		arg.Name,
+		arg.Name,
+		arg.Format,
+		arg.Format,
+		arg.MainCardID,
+		arg.MainCardID,
+		arg.MainCardTypeID,
+		arg.CardID,
+		arg.CardID,
+		arg.CardTypeID,
+		arg.CursorID,
+		arg.Descending,
+		arg.CursorValue,
+		arg.CursorValue,
+		arg.CursorID,
+		arg.Descending,
+		arg.CursorValue,
+		arg.CursorValue,
+		arg.CursorID,
+		arg.Descending,
+		arg.Descending,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindDeckPageRow{}
+	for rows.Next() {
+		var i FindDeckPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.MainCardID,
+			&i.MainCardTypeID,
+			&i.SubCardID,
+			&i.SubCardTypeID,
+			&i.Format,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CardCount,
+			&i.SortValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateDeck = `-- name: UpdateDeck :exec
 UPDATE decks
 SET 
@@ -195,7 +342,9 @@ SET
   main_card_id = ?,
   main_card_type_id = ?,
   sub_card_id = ?,
-  sub_card_type_id = ?
+  sub_card_type_id = ?,
+  format = ?,
+  owner_id = ?
 WHERE id = ?
 `
 
@@ -206,6 +355,8 @@ type UpdateDeckParams struct {
 	MainCardTypeID sql.NullInt64  `json:"main_card_type_id"`
 	SubCardID      sql.NullInt64  `json:"sub_card_id"`
 	SubCardTypeID  sql.NullInt64  `json:"sub_card_type_id"`
+	Format         string         `json:"format"`
+	OwnerID        sql.NullString `json:"owner_id"`
 	ID             int64          `json:"id"`
 }
 
@@ -217,6 +368,8 @@ func (q *Queries) UpdateDeck(ctx context.Context, arg UpdateDeckParams) error {
 		arg.MainCardTypeID,
 		arg.SubCardID,
 		arg.SubCardTypeID,
+		arg.Format,
+		arg.OwnerID,
 		arg.ID,
 	)
 	return err
@@ -7,10 +7,12 @@ package dbgen
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 )
 
 const energyFindById = `-- name: EnergyFindById :one
-SELECT id, name, image_url, description, regulation, expansion, created_at, updated_at
+SELECT id, name, image_url, description, regulation, expansion, card_number, ace_spec, prism_star, created_at, updated_at
 FROM energies
 WHERE id = ?
 `
@@ -25,8 +27,248 @@ func (q *Queries) EnergyFindById(ctx context.Context, id int64) (Energy, error)
 		&i.Description,
 		&i.Regulation,
 		&i.Expansion,
+		&i.CardNumber,
+		&i.AceSpec,
+		&i.PrismStar,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
+
+const energyFindByIds = `-- name: EnergyFindByIds :many
+SELECT id, name, image_url, description, regulation, expansion, card_number, ace_spec, prism_star, created_at, updated_at FROM energies
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) EnergyFindByIds(ctx context.Context, ids []int64) ([]Energy, error) {
+	query := energyFindByIds
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Energy{}
+	for rows.Next() {
+		var i Energy
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ImageUrl,
+			&i.Description,
+			&i.Regulation,
+			&i.Expansion,
+			&i.CardNumber,
+			&i.AceSpec,
+			&i.PrismStar,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchEnergiesByFulltext = `-- name: SearchEnergiesByFulltext :many
+SELECT energies.id, energies.name, energies.image_url, energies.description, energies.regulation, energies.expansion, energies.card_number, energies.ace_spec, energies.prism_star, energies.created_at, energies.updated_at, COUNT(*) OVER () AS total_hits
+FROM energies
+WHERE MATCH(name) AGAINST (? IN BOOLEAN MODE)
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  CASE WHEN ? = 'relevance' THEN MATCH(name) AGAINST (? IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchEnergiesByFulltextParams struct {
+	Query          string       `json:"query"`
+	AllRegulations bool         `json:"all_regulations"`
+	Regulations    []string     `json:"regulations"`
+	AllExpansions  bool         `json:"all_expansions"`
+	Expansions     []string     `json:"expansions"`
+	AceSpec        sql.NullBool `json:"ace_spec"`
+	Sort           string       `json:"sort"`
+	Limit          int32        `json:"limit"`
+	Offset         int32        `json:"offset"`
+}
+
+type SearchEnergiesByFulltextRow struct {
+	Energy    Energy `json:"energy"`
+	TotalHits int64  `json:"total_hits"`
+}
+
+func (q *Queries) SearchEnergiesByFulltext(ctx context.Context, arg SearchEnergiesByFulltextParams) ([]SearchEnergiesByFulltextRow, error) {
+	query := searchEnergiesByFulltext
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchEnergiesByFulltextRow{}
+	for rows.Next() {
+		var i SearchEnergiesByFulltextRow
+		if err := rows.Scan(
+			&i.Energy.ID,
+			&i.Energy.Name,
+			&i.Energy.ImageUrl,
+			&i.Energy.Description,
+			&i.Energy.Regulation,
+			&i.Energy.Expansion,
+			&i.Energy.CardNumber,
+			&i.Energy.AceSpec,
+			&i.Energy.PrismStar,
+			&i.Energy.CreatedAt,
+			&i.Energy.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchEnergiesByName = `-- name: SearchEnergiesByName :many
+SELECT energies.id, energies.name, energies.image_url, energies.description, energies.regulation, energies.expansion, energies.card_number, energies.ace_spec, energies.prism_star, energies.created_at, energies.updated_at, COUNT(*) OVER () AS total_hits
+FROM energies
+WHERE name LIKE CONCAT('%', ?, '%')
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchEnergiesByNameParams struct {
+	Query          string       `json:"query"`
+	AllRegulations bool         `json:"all_regulations"`
+	Regulations    []string     `json:"regulations"`
+	AllExpansions  bool         `json:"all_expansions"`
+	Expansions     []string     `json:"expansions"`
+	AceSpec        sql.NullBool `json:"ace_spec"`
+	Sort           string       `json:"sort"`
+	Limit          int32        `json:"limit"`
+	Offset         int32        `json:"offset"`
+}
+
+type SearchEnergiesByNameRow struct {
+	Energy    Energy `json:"energy"`
+	TotalHits int64  `json:"total_hits"`
+}
+
+func (q *Queries) SearchEnergiesByName(ctx context.Context, arg SearchEnergiesByNameParams) ([]SearchEnergiesByNameRow, error) {
+	query := searchEnergiesByName
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchEnergiesByNameRow{}
+	for rows.Next() {
+		var i SearchEnergiesByNameRow
+		if err := rows.Scan(
+			&i.Energy.ID,
+			&i.Energy.Name,
+			&i.Energy.ImageUrl,
+			&i.Energy.Description,
+			&i.Energy.Regulation,
+			&i.Energy.Expansion,
+			&i.Energy.CardNumber,
+			&i.Energy.AceSpec,
+			&i.Energy.PrismStar,
+			&i.Energy.CreatedAt,
+			&i.Energy.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -6,9 +6,21 @@ package dbgen
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type ApiKey struct {
+	ID        int64        `json:"id"`
+	UserID    string       `json:"user_id"`
+	Name      string       `json:"name"`
+	Prefix    string       `json:"prefix"`
+	KeyHash   string       `json:"key_hash"`
+	Scopes    string       `json:"scopes"`
+	RevokedAt sql.NullTime `json:"revoked_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
 type CardType struct {
 	ID        int64     `json:"id"`
 	Name      string    `json:"name"`
@@ -24,6 +36,8 @@ type Deck struct {
 	MainCardTypeID sql.NullInt64  `json:"main_card_type_id"`
 	SubCardID      sql.NullInt64  `json:"sub_card_id"`
 	SubCardTypeID  sql.NullInt64  `json:"sub_card_type_id"`
+	Format         string         `json:"format"`
+	OwnerID        sql.NullString `json:"owner_id"`
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
 }
@@ -38,6 +52,16 @@ type DeckCard struct {
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
+type DeckVersion struct {
+	ID        int64           `json:"id"`
+	DeckID    int64           `json:"deck_id"`
+	Version   int32           `json:"version"`
+	Name      string          `json:"name"`
+	CardCount int32           `json:"card_count"`
+	Snapshot  json.RawMessage `json:"snapshot"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
 type Energy struct {
 	ID          int64     `json:"id"`
 	Name        string    `json:"name"`
@@ -45,6 +69,9 @@ type Energy struct {
 	Description string    `json:"description"`
 	Regulation  string    `json:"regulation"`
 	Expansion   string    `json:"expansion"`
+	CardNumber  string    `json:"card_number"`
+	AceSpec     bool      `json:"ace_spec"`
+	PrismStar   bool      `json:"prism_star"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -59,6 +86,13 @@ type Pokemon struct {
 	AbilityDescription sql.NullString `json:"ability_description"`
 	Regulation         string         `json:"regulation"`
 	Expansion          string         `json:"expansion"`
+	CardNumber         string         `json:"card_number"`
+	Stage              sql.NullString `json:"stage"`
+	EvolvesFrom        sql.NullString `json:"evolves_from"`
+	AceSpec            bool           `json:"ace_spec"`
+	Radiant            bool           `json:"radiant"`
+	PrismStar          bool           `json:"prism_star"`
+	RuleBox            bool           `json:"rule_box"`
 	CreatedAt          time.Time      `json:"created_at"`
 	UpdatedAt          time.Time      `json:"updated_at"`
 }
@@ -74,6 +108,12 @@ type PokemonAttack struct {
 	UpdatedAt      time.Time      `json:"updated_at"`
 }
 
+type SearchIndexWatermark struct {
+	IndexUid     string    `json:"index_uid"`
+	IndexedUntil time.Time `json:"indexed_until"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type Trainer struct {
 	ID          int64     `json:"id"`
 	Name        string    `json:"name"`
@@ -82,6 +122,28 @@ type Trainer struct {
 	Description string    `json:"description"`
 	Regulation  string    `json:"regulation"`
 	Expansion   string    `json:"expansion"`
+	CardNumber  string    `json:"card_number"`
+	AceSpec     bool      `json:"ace_spec"`
+	PrismStar   bool      `json:"prism_star"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
+
+type Translation struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	Source    string    `json:"source"`
+	Lang      string    `json:"lang"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type User struct {
+	ID           string    `json:"id"`
+	Email        string    `json:"email"`
+	Name         string    `json:"name"`
+	PasswordHash string    `json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
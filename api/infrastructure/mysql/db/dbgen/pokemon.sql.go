@@ -7,6 +7,8 @@ package dbgen
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 )
 
 const pokemonAttackFindByPokemonId = `-- name: PokemonAttackFindByPokemonId :many
@@ -47,7 +49,7 @@ func (q *Queries) PokemonAttackFindByPokemonId(ctx context.Context, pokemonID in
 }
 
 const pokemonFindById = `-- name: PokemonFindById :one
-SELECT id, name, energy_type, image_url, hp, ability, ability_description, regulation, expansion, created_at, updated_at FROM pokemons
+SELECT id, name, energy_type, image_url, hp, ability, ability_description, regulation, expansion, card_number, stage, evolves_from, ace_spec, radiant, prism_star, rule_box, created_at, updated_at FROM pokemons
 WHERE id = ? LIMIT 1
 `
 
@@ -64,8 +66,319 @@ func (q *Queries) PokemonFindById(ctx context.Context, id int64) (Pokemon, error
 		&i.AbilityDescription,
 		&i.Regulation,
 		&i.Expansion,
+		&i.CardNumber,
+		&i.Stage,
+		&i.EvolvesFrom,
+		&i.AceSpec,
+		&i.Radiant,
+		&i.PrismStar,
+		&i.RuleBox,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
+
+const pokemonFindByIds = `-- name: PokemonFindByIds :many
+SELECT id, name, energy_type, image_url, hp, ability, ability_description, regulation, expansion, card_number, stage, evolves_from, ace_spec, radiant, prism_star, rule_box, created_at, updated_at FROM pokemons
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) PokemonFindByIds(ctx context.Context, ids []int64) ([]Pokemon, error) {
+	query := pokemonFindByIds
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Pokemon{}
+	for rows.Next() {
+		var i Pokemon
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.EnergyType,
+			&i.ImageUrl,
+			&i.Hp,
+			&i.Ability,
+			&i.AbilityDescription,
+			&i.Regulation,
+			&i.Expansion,
+			&i.CardNumber,
+			&i.Stage,
+			&i.EvolvesFrom,
+			&i.AceSpec,
+			&i.Radiant,
+			&i.PrismStar,
+			&i.RuleBox,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPokemonsByFulltext = `-- name: SearchPokemonsByFulltext :many
+SELECT pokemons.id, pokemons.name, pokemons.energy_type, pokemons.image_url, pokemons.hp, pokemons.ability, pokemons.ability_description, pokemons.regulation, pokemons.expansion, pokemons.card_number, pokemons.stage, pokemons.evolves_from, pokemons.ace_spec, pokemons.radiant, pokemons.prism_star, pokemons.rule_box, pokemons.created_at, pokemons.updated_at, COUNT(*) OVER () AS total_hits
+FROM pokemons
+WHERE MATCH(name) AGAINST (? IN BOOLEAN MODE)
+  AND (? OR energy_type IN (/*SLICE:energy_types*/?))
+  AND hp BETWEEN ? AND ?
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+  AND (? IS NULL OR (COALESCE(ability, '') <> '') = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  CASE WHEN ? = 'hp' THEN hp END DESC,
+  CASE WHEN ? = 'relevance' THEN MATCH(name) AGAINST (? IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchPokemonsByFulltextParams struct {
+	Query          string       `json:"query"`
+	AllEnergyTypes bool         `json:"all_energy_types"`
+	EnergyTypes    []string     `json:"energy_types"`
+	MinHp          int64        `json:"min_hp"`
+	MaxHp          int64        `json:"max_hp"`
+	AllRegulations bool         `json:"all_regulations"`
+	Regulations    []string     `json:"regulations"`
+	AllExpansions  bool         `json:"all_expansions"`
+	Expansions     []string     `json:"expansions"`
+	AceSpec        sql.NullBool `json:"ace_spec"`
+	HasAbility     sql.NullBool `json:"has_ability"`
+	Sort           string       `json:"sort"`
+	Limit          int32        `json:"limit"`
+	Offset         int32        `json:"offset"`
+}
+
+type SearchPokemonsByFulltextRow struct {
+	Pokemon   Pokemon `json:"pokemon"`
+	TotalHits int64   `json:"total_hits"`
+}
+
+func (q *Queries) SearchPokemonsByFulltext(ctx context.Context, arg SearchPokemonsByFulltextParams) ([]SearchPokemonsByFulltextRow, error) {
+	query := searchPokemonsByFulltext
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllEnergyTypes)
+	if len(arg.EnergyTypes) > 0 {
+		for _, v := range arg.EnergyTypes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:energy_types*/?", strings.Repeat(",?", len(arg.EnergyTypes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:energy_types*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.MinHp)
+	queryParams = append(queryParams, arg.MaxHp)
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.HasAbility)
+	queryParams = append(queryParams, arg.HasAbility)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchPokemonsByFulltextRow{}
+	for rows.Next() {
+		var i SearchPokemonsByFulltextRow
+		if err := rows.Scan(
+			&i.Pokemon.ID,
+			&i.Pokemon.Name,
+			&i.Pokemon.EnergyType,
+			&i.Pokemon.ImageUrl,
+			&i.Pokemon.Hp,
+			&i.Pokemon.Ability,
+			&i.Pokemon.AbilityDescription,
+			&i.Pokemon.Regulation,
+			&i.Pokemon.Expansion,
+			&i.Pokemon.CardNumber,
+			&i.Pokemon.Stage,
+			&i.Pokemon.EvolvesFrom,
+			&i.Pokemon.AceSpec,
+			&i.Pokemon.Radiant,
+			&i.Pokemon.PrismStar,
+			&i.Pokemon.RuleBox,
+			&i.Pokemon.CreatedAt,
+			&i.Pokemon.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPokemonsByName = `-- name: SearchPokemonsByName :many
+SELECT pokemons.id, pokemons.name, pokemons.energy_type, pokemons.image_url, pokemons.hp, pokemons.ability, pokemons.ability_description, pokemons.regulation, pokemons.expansion, pokemons.card_number, pokemons.stage, pokemons.evolves_from, pokemons.ace_spec, pokemons.radiant, pokemons.prism_star, pokemons.rule_box, pokemons.created_at, pokemons.updated_at, COUNT(*) OVER () AS total_hits
+FROM pokemons
+WHERE name LIKE CONCAT('%', ?, '%')
+  AND (? OR energy_type IN (/*SLICE:energy_types*/?))
+  AND hp BETWEEN ? AND ?
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+  AND (? IS NULL OR (COALESCE(ability, '') <> '') = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  CASE WHEN ? = 'hp' THEN hp END DESC,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchPokemonsByNameParams struct {
+	Query          string       `json:"query"`
+	AllEnergyTypes bool         `json:"all_energy_types"`
+	EnergyTypes    []string     `json:"energy_types"`
+	MinHp          int64        `json:"min_hp"`
+	MaxHp          int64        `json:"max_hp"`
+	AllRegulations bool         `json:"all_regulations"`
+	Regulations    []string     `json:"regulations"`
+	AllExpansions  bool         `json:"all_expansions"`
+	Expansions     []string     `json:"expansions"`
+	AceSpec        sql.NullBool `json:"ace_spec"`
+	HasAbility     sql.NullBool `json:"has_ability"`
+	Sort           string       `json:"sort"`
+	Limit          int32        `json:"limit"`
+	Offset         int32        `json:"offset"`
+}
+
+type SearchPokemonsByNameRow struct {
+	Pokemon   Pokemon `json:"pokemon"`
+	TotalHits int64   `json:"total_hits"`
+}
+
+func (q *Queries) SearchPokemonsByName(ctx context.Context, arg SearchPokemonsByNameParams) ([]SearchPokemonsByNameRow, error) {
+	query := searchPokemonsByName
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllEnergyTypes)
+	if len(arg.EnergyTypes) > 0 {
+		for _, v := range arg.EnergyTypes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:energy_types*/?", strings.Repeat(",?", len(arg.EnergyTypes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:energy_types*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.MinHp)
+	queryParams = append(queryParams, arg.MaxHp)
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.HasAbility)
+	queryParams = append(queryParams, arg.HasAbility)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchPokemonsByNameRow{}
+	for rows.Next() {
+		var i SearchPokemonsByNameRow
+		if err := rows.Scan(
+			&i.Pokemon.ID,
+			&i.Pokemon.Name,
+			&i.Pokemon.EnergyType,
+			&i.Pokemon.ImageUrl,
+			&i.Pokemon.Hp,
+			&i.Pokemon.Ability,
+			&i.Pokemon.AbilityDescription,
+			&i.Pokemon.Regulation,
+			&i.Pokemon.Expansion,
+			&i.Pokemon.CardNumber,
+			&i.Pokemon.Stage,
+			&i.Pokemon.EvolvesFrom,
+			&i.Pokemon.AceSpec,
+			&i.Pokemon.Radiant,
+			&i.Pokemon.PrismStar,
+			&i.Pokemon.RuleBox,
+			&i.Pokemon.CreatedAt,
+			&i.Pokemon.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
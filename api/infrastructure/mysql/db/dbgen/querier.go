@@ -10,17 +10,44 @@ import (
 )
 
 type Querier interface {
+	CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (sql.Result, error)
 	CreateDeck(ctx context.Context, arg CreateDeckParams) (sql.Result, error)
 	CreateDeckCard(ctx context.Context, arg CreateDeckCardParams) (sql.Result, error)
+	CreateDeckVersion(ctx context.Context, arg CreateDeckVersionParams) (sql.Result, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) error
 	DeleteDeck(ctx context.Context, id int64) error
 	DeleteDeckCardsByDeckId(ctx context.Context, deckID int64) error
 	EnergyFindById(ctx context.Context, id int64) (Energy, error)
-	FindALl(ctx context.Context) ([]Deck, error)
+	EnergyFindByIds(ctx context.Context, ids []int64) ([]Energy, error)
+	FindApiKeyByHash(ctx context.Context, keyHash string) (ApiKey, error)
+	FindApiKeyById(ctx context.Context, id int64) (ApiKey, error)
+	FindApiKeysByUserId(ctx context.Context, userID string) ([]ApiKey, error)
+	FindCardsByExpansionAndNumber(ctx context.Context, arg FindCardsByExpansionAndNumberParams) ([]FindCardsByExpansionAndNumberRow, error)
+	FindCardsByName(ctx context.Context, name string) ([]FindCardsByNameRow, error)
 	FindDeckById(ctx context.Context, id int64) (Deck, error)
 	FindDeckCardsByDeckId(ctx context.Context, deckID int64) ([]DeckCard, error)
+	FindDeckCardsByDeckIds(ctx context.Context, deckIds []int64) ([]DeckCard, error)
+	FindDeckPage(ctx context.Context, arg FindDeckPageParams) ([]FindDeckPageRow, error)
+	FindDeckVersion(ctx context.Context, arg FindDeckVersionParams) (DeckVersion, error)
+	FindDeckVersionsByDeckId(ctx context.Context, deckID int64) ([]DeckVersion, error)
+	FindLatestDeckVersion(ctx context.Context, deckID int64) (int64, error)
+	FindTranslations(ctx context.Context, arg FindTranslationsParams) ([]FindTranslationsRow, error)
+	FindUserByEmail(ctx context.Context, email string) (User, error)
+	FindUserById(ctx context.Context, id string) (User, error)
+	ListCardNames(ctx context.Context) ([]ListCardNamesRow, error)
 	PokemonAttackFindByPokemonId(ctx context.Context, pokemonID int64) ([]PokemonAttack, error)
 	PokemonFindById(ctx context.Context, id int64) (Pokemon, error)
+	PokemonFindByIds(ctx context.Context, ids []int64) ([]Pokemon, error)
+	RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (sql.Result, error)
+	SearchCardsByName(ctx context.Context, arg SearchCardsByNameParams) ([]SearchCardsByNameRow, error)
+	SearchEnergiesByFulltext(ctx context.Context, arg SearchEnergiesByFulltextParams) ([]SearchEnergiesByFulltextRow, error)
+	SearchEnergiesByName(ctx context.Context, arg SearchEnergiesByNameParams) ([]SearchEnergiesByNameRow, error)
+	SearchPokemonsByFulltext(ctx context.Context, arg SearchPokemonsByFulltextParams) ([]SearchPokemonsByFulltextRow, error)
+	SearchPokemonsByName(ctx context.Context, arg SearchPokemonsByNameParams) ([]SearchPokemonsByNameRow, error)
+	SearchTrainersByFulltext(ctx context.Context, arg SearchTrainersByFulltextParams) ([]SearchTrainersByFulltextRow, error)
+	SearchTrainersByName(ctx context.Context, arg SearchTrainersByNameParams) ([]SearchTrainersByNameRow, error)
 	TrainerFindById(ctx context.Context, id int64) (Trainer, error)
+	TrainerFindByIds(ctx context.Context, ids []int64) ([]Trainer, error)
 	UpdateDeck(ctx context.Context, arg UpdateDeckParams) error
 }
 
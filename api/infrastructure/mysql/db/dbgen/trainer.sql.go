@@ -7,10 +7,12 @@ package dbgen
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 )
 
 const trainerFindById = `-- name: TrainerFindById :one
-SELECT id, name, trainer_type, image_url, description, regulation, expansion, created_at, updated_at FROM trainers
+SELECT id, name, trainer_type, image_url, description, regulation, expansion, card_number, ace_spec, prism_star, created_at, updated_at FROM trainers
 WHERE id = ? LIMIT 1
 `
 
@@ -25,8 +27,275 @@ func (q *Queries) TrainerFindById(ctx context.Context, id int64) (Trainer, error
 		&i.Description,
 		&i.Regulation,
 		&i.Expansion,
+		&i.CardNumber,
+		&i.AceSpec,
+		&i.PrismStar,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
+
+const trainerFindByIds = `-- name: TrainerFindByIds :many
+SELECT id, name, trainer_type, image_url, description, regulation, expansion, card_number, ace_spec, prism_star, created_at, updated_at FROM trainers
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) TrainerFindByIds(ctx context.Context, ids []int64) ([]Trainer, error) {
+	query := trainerFindByIds
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Trainer{}
+	for rows.Next() {
+		var i Trainer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TrainerType,
+			&i.ImageUrl,
+			&i.Description,
+			&i.Regulation,
+			&i.Expansion,
+			&i.CardNumber,
+			&i.AceSpec,
+			&i.PrismStar,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchTrainersByFulltext = `-- name: SearchTrainersByFulltext :many
+SELECT trainers.id, trainers.name, trainers.trainer_type, trainers.image_url, trainers.description, trainers.regulation, trainers.expansion, trainers.card_number, trainers.ace_spec, trainers.prism_star, trainers.created_at, trainers.updated_at, COUNT(*) OVER () AS total_hits
+FROM trainers
+WHERE MATCH(name) AGAINST (? IN BOOLEAN MODE)
+  AND (? OR trainer_type IN (/*SLICE:trainer_types*/?))
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  CASE WHEN ? = 'relevance' THEN MATCH(name) AGAINST (? IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchTrainersByFulltextParams struct {
+	Query           string       `json:"query"`
+	AllTrainerTypes bool         `json:"all_trainer_types"`
+	TrainerTypes    []string     `json:"trainer_types"`
+	AllRegulations  bool         `json:"all_regulations"`
+	Regulations     []string     `json:"regulations"`
+	AllExpansions   bool         `json:"all_expansions"`
+	Expansions      []string     `json:"expansions"`
+	AceSpec         sql.NullBool `json:"ace_spec"`
+	Sort            string       `json:"sort"`
+	Limit           int32        `json:"limit"`
+	Offset          int32        `json:"offset"`
+}
+
+type SearchTrainersByFulltextRow struct {
+	Trainer   Trainer `json:"trainer"`
+	TotalHits int64   `json:"total_hits"`
+}
+
+func (q *Queries) SearchTrainersByFulltext(ctx context.Context, arg SearchTrainersByFulltextParams) ([]SearchTrainersByFulltextRow, error) {
+	query := searchTrainersByFulltext
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllTrainerTypes)
+	if len(arg.TrainerTypes) > 0 {
+		for _, v := range arg.TrainerTypes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:trainer_types*/?", strings.Repeat(",?", len(arg.TrainerTypes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:trainer_types*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchTrainersByFulltextRow{}
+	for rows.Next() {
+		var i SearchTrainersByFulltextRow
+		if err := rows.Scan(
+			&i.Trainer.ID,
+			&i.Trainer.Name,
+			&i.Trainer.TrainerType,
+			&i.Trainer.ImageUrl,
+			&i.Trainer.Description,
+			&i.Trainer.Regulation,
+			&i.Trainer.Expansion,
+			&i.Trainer.CardNumber,
+			&i.Trainer.AceSpec,
+			&i.Trainer.PrismStar,
+			&i.Trainer.CreatedAt,
+			&i.Trainer.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchTrainersByName = `-- name: SearchTrainersByName :many
+SELECT trainers.id, trainers.name, trainers.trainer_type, trainers.image_url, trainers.description, trainers.regulation, trainers.expansion, trainers.card_number, trainers.ace_spec, trainers.prism_star, trainers.created_at, trainers.updated_at, COUNT(*) OVER () AS total_hits
+FROM trainers
+WHERE name LIKE CONCAT('%', ?, '%')
+  AND (? OR trainer_type IN (/*SLICE:trainer_types*/?))
+  AND (? OR regulation IN (/*SLICE:regulations*/?))
+  AND (? OR expansion IN (/*SLICE:expansions*/?))
+  AND (? IS NULL OR ace_spec = ?)
+ORDER BY
+  CASE WHEN ? = 'name' THEN name END,
+  id DESC
+LIMIT ? OFFSET ?
+`
+
+type SearchTrainersByNameParams struct {
+	Query           string       `json:"query"`
+	AllTrainerTypes bool         `json:"all_trainer_types"`
+	TrainerTypes    []string     `json:"trainer_types"`
+	AllRegulations  bool         `json:"all_regulations"`
+	Regulations     []string     `json:"regulations"`
+	AllExpansions   bool         `json:"all_expansions"`
+	Expansions      []string     `json:"expansions"`
+	AceSpec         sql.NullBool `json:"ace_spec"`
+	Sort            string       `json:"sort"`
+	Limit           int32        `json:"limit"`
+	Offset          int32        `json:"offset"`
+}
+
+type SearchTrainersByNameRow struct {
+	Trainer   Trainer `json:"trainer"`
+	TotalHits int64   `json:"total_hits"`
+}
+
+func (q *Queries) SearchTrainersByName(ctx context.Context, arg SearchTrainersByNameParams) ([]SearchTrainersByNameRow, error) {
+	query := searchTrainersByName
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Query)
+	queryParams = append(queryParams, arg.AllTrainerTypes)
+	if len(arg.TrainerTypes) > 0 {
+		for _, v := range arg.TrainerTypes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:trainer_types*/?", strings.Repeat(",?", len(arg.TrainerTypes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:trainer_types*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllRegulations)
+	if len(arg.Regulations) > 0 {
+		for _, v := range arg.Regulations {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:regulations*/?", strings.Repeat(",?", len(arg.Regulations))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:regulations*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AllExpansions)
+	if len(arg.Expansions) > 0 {
+		for _, v := range arg.Expansions {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:expansions*/?", strings.Repeat(",?", len(arg.Expansions))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:expansions*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.AceSpec)
+	queryParams = append(queryParams, arg.Sort)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchTrainersByNameRow{}
+	for rows.Next() {
+		var i SearchTrainersByNameRow
+		if err := rows.Scan(
+			&i.Trainer.ID,
+			&i.Trainer.Name,
+			&i.Trainer.TrainerType,
+			&i.Trainer.ImageUrl,
+			&i.Trainer.Description,
+			&i.Trainer.Regulation,
+			&i.Trainer.Expansion,
+			&i.Trainer.CardNumber,
+			&i.Trainer.AceSpec,
+			&i.Trainer.PrismStar,
+			&i.Trainer.CreatedAt,
+			&i.Trainer.UpdatedAt,
+			&i.TotalHits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
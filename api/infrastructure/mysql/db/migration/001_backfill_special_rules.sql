@@ -0,0 +1,15 @@
+-- ace_spec・radiant・prism_star列を追加する前に登録したカードのフラグを設定する
+-- schema.sqlを適用した後に一度だけ実行する。何度実行しても結果は変わらない
+
+UPDATE `trainers` SET `ace_spec` = TRUE
+WHERE `trainer_type` IN ('グッズ特別なルール', 'ポケモンのどうぐ特別なルール', 'スタジアム特別なルール');
+
+UPDATE `energies` SET `ace_spec` = TRUE
+WHERE `name` IN ('レガシーエネルギー', 'リッチエネルギー', 'ネオアッパーエネルギー');
+
+UPDATE `pokemons` SET `radiant` = TRUE
+WHERE `name` LIKE 'かがやく%';
+
+UPDATE `pokemons` SET `prism_star` = TRUE WHERE `name` LIKE '%◇%';
+UPDATE `trainers` SET `prism_star` = TRUE WHERE `name` LIKE '%◇%';
+UPDATE `energies` SET `prism_star` = TRUE WHERE `name` LIKE '%◇%';
@@ -0,0 +1,5 @@
+-- rule_box列を追加する前に登録したポケモンのうち、名前の末尾がルールを持つポケモンのものを設定する
+-- 大文字と小文字を区別して判定する
+
+UPDATE `pokemons` SET `rule_box` = TRUE
+WHERE REGEXP_LIKE(`name`, '(ex|EX|GX|V|VMAX|VSTAR|V-UNION|BREAK)$', 'c');
@@ -0,0 +1,23 @@
+-- stage列を追加したときに既定値の「たね」が入ったポケモンの進化段階を、名前から分かるものだけ設定し直す
+-- 名前から分からないものはNULLに戻し、デッキの検査ではたねかどうか分からないポケモンとして扱う
+-- 進化段階を登録したカードもNULLに戻ってしまうので、カードのデータから進化段階を登録する前に一度だけ実行する
+
+UPDATE `pokemons` SET `stage` = NULL
+WHERE `stage` = 'たね' AND `evolves_from` IS NULL;
+
+-- ポケモンVとかがやくポケモンは必ずたね
+UPDATE `pokemons` SET `stage` = 'たね'
+WHERE `stage` IS NULL AND (REGEXP_LIKE(`name`, 'V$', 'c') OR `name` LIKE 'かがやく%');
+
+-- VMAXとVSTARは同じ名前のポケモンVから、BREAKはBREAKの付かない同じ名前のポケモンから進化する
+UPDATE `pokemons` SET `stage` = 'VMAX', `evolves_from` = CONCAT(LEFT(`name`, CHAR_LENGTH(`name`) - 4), 'V')
+WHERE `stage` IS NULL AND REGEXP_LIKE(`name`, 'VMAX$', 'c');
+
+UPDATE `pokemons` SET `stage` = 'VSTAR', `evolves_from` = CONCAT(LEFT(`name`, CHAR_LENGTH(`name`) - 5), 'V')
+WHERE `stage` IS NULL AND REGEXP_LIKE(`name`, 'VSTAR$', 'c');
+
+UPDATE `pokemons` SET `stage` = 'BREAK', `evolves_from` = LEFT(`name`, CHAR_LENGTH(`name`) - 5)
+WHERE `stage` IS NULL AND REGEXP_LIKE(`name`, 'BREAK$', 'c');
+
+UPDATE `pokemons` SET `stage` = 'V-UNION'
+WHERE `stage` IS NULL AND REGEXP_LIKE(`name`, 'V-UNION$', 'c');
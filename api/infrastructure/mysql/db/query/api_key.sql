@@ -0,0 +1,30 @@
+-- name: CreateApiKey :execresult
+INSERT INTO api_keys (
+  user_id,
+  name,
+  prefix,
+  key_hash,
+  scopes
+) VALUES (
+  ?, ?, ?, ?, ?
+);
+
+-- name: FindApiKeyById :one
+SELECT * FROM api_keys
+WHERE id = ?
+LIMIT 1;
+
+-- name: FindApiKeyByHash :one
+SELECT * FROM api_keys
+WHERE key_hash = ?
+LIMIT 1;
+
+-- name: FindApiKeysByUserId :many
+SELECT * FROM api_keys
+WHERE user_id = ?
+ORDER BY id DESC;
+
+-- name: RevokeApiKey :execresult
+UPDATE api_keys
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = ? AND user_id = ? AND revoked_at IS NULL;
@@ -0,0 +1,40 @@
+-- name: FindCardsByExpansionAndNumber :many
+SELECT id, CAST(1 AS SIGNED) AS card_type_id, name, expansion, card_number FROM pokemons
+WHERE expansion = sqlc.arg(expansion) AND card_number = sqlc.arg(card_number)
+UNION ALL
+SELECT id, CAST(2 AS SIGNED) AS card_type_id, name, expansion, card_number FROM trainers
+WHERE expansion = sqlc.arg(expansion) AND card_number = sqlc.arg(card_number)
+UNION ALL
+SELECT id, CAST(3 AS SIGNED) AS card_type_id, name, expansion, card_number FROM energies
+WHERE expansion = sqlc.arg(expansion) AND card_number = sqlc.arg(card_number);
+
+-- name: FindCardsByName :many
+SELECT id, CAST(1 AS SIGNED) AS card_type_id, name, expansion, card_number FROM pokemons
+WHERE name = sqlc.arg(name)
+UNION ALL
+SELECT id, CAST(2 AS SIGNED) AS card_type_id, name, expansion, card_number FROM trainers
+WHERE name = sqlc.arg(name)
+UNION ALL
+SELECT id, CAST(3 AS SIGNED) AS card_type_id, name, expansion, card_number FROM energies
+WHERE name = sqlc.arg(name)
+ORDER BY id DESC;
+
+-- name: SearchCardsByName :many
+SELECT id, CAST(1 AS SIGNED) AS card_type_id, name, expansion, card_number FROM pokemons
+WHERE name LIKE CONCAT('%', sqlc.arg(name), '%')
+UNION ALL
+SELECT id, CAST(2 AS SIGNED) AS card_type_id, name, expansion, card_number FROM trainers
+WHERE name LIKE CONCAT('%', sqlc.arg(name), '%')
+UNION ALL
+SELECT id, CAST(3 AS SIGNED) AS card_type_id, name, expansion, card_number FROM energies
+WHERE name LIKE CONCAT('%', sqlc.arg(name), '%')
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: ListCardNames :many
+SELECT id, CAST(1 AS SIGNED) AS card_type_id, name FROM pokemons
+UNION ALL
+SELECT id, CAST(2 AS SIGNED) AS card_type_id, name FROM trainers
+UNION ALL
+SELECT id, CAST(3 AS SIGNED) AS card_type_id, name FROM energies
+ORDER BY id DESC;
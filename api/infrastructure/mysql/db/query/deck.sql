@@ -5,9 +5,11 @@ INSERT INTO decks (
   main_card_id,
   main_card_type_id,
   sub_card_id,
-  sub_card_type_id
+  sub_card_type_id,
+  format,
+  owner_id
 ) VALUES (
-  ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?
 );
 
 -- name: CreateDeckCard :execresult
@@ -20,10 +22,6 @@ INSERT INTO deck_cards (
   ?, ?, ?, ?
 );
 
--- name: FindALl :many
-SELECT * FROM decks
-ORDER BY id DESC;
-
 -- name: FindDeckById :one
 SELECT * FROM decks
 WHERE id = ?
@@ -33,6 +31,43 @@ LIMIT 1;
 SELECT * FROM deck_cards
 WHERE deck_id = ?;
 
+-- name: FindDeckCardsByDeckIds :many
+SELECT * FROM deck_cards
+WHERE deck_id IN (sqlc.slice('deck_ids'))
+ORDER BY deck_id, id;
+
+-- name: FindDeckPage :many
+SELECT
+  id, name, description, main_card_id, main_card_type_id, sub_card_id, sub_card_type_id, format, owner_id, created_at, updated_at, card_count, sort_value
+FROM (
+  SELECT
+    d.*,
+    (SELECT CAST(COALESCE(SUM(dc.quantity), 0) AS SIGNED) FROM deck_cards dc WHERE dc.deck_id = d.id) AS card_count,
+    CAST(CASE sqlc.arg('sort_key')
+      WHEN 'name' THEN d.name
+      WHEN 'updated_at' THEN DATE_FORMAT(d.updated_at, '%Y-%m-%d %H:%i:%s')
+      ELSE DATE_FORMAT(d.created_at, '%Y-%m-%d %H:%i:%s')
+    END AS CHAR) AS sort_value
+  FROM decks d
+  WHERE (d.owner_id = sqlc.arg('owner_id') OR (sqlc.arg('include_unowned') AND d.owner_id IS NULL))
+    AND (sqlc.arg('name') = '' OR d.name LIKE CONCAT('%', sqlc.arg('name'), '%'))
+    AND (sqlc.arg('format') = '' OR d.format = sqlc.arg('format'))
+    AND (sqlc.arg('main_card_id') = 0 OR (d.main_card_id = sqlc.arg('main_card_id') AND d.main_card_type_id = sqlc.arg('main_card_type_id')))
+    AND (sqlc.arg('card_id') = 0 OR EXISTS (
+      SELECT 1 FROM deck_cards dc
+      WHERE dc.deck_id = d.id AND dc.card_id = sqlc.arg('card_id') AND dc.card_type_id = sqlc.arg('card_type_id')
+    ))
+) AS page
+WHERE sqlc.arg('cursor_id') = 0
+  OR (sqlc.arg('descending') AND (sort_value < sqlc.arg('cursor_value') OR (sort_value = sqlc.arg('cursor_value') AND id < sqlc.arg('cursor_id'))))
+  OR (NOT sqlc.arg('descending') AND (sort_value > sqlc.arg('cursor_value') OR (sort_value = sqlc.arg('cursor_value') AND id > sqlc.arg('cursor_id'))))
+ORDER BY
+  CASE WHEN sqlc.arg('descending') THEN sort_value END DESC,
+  CASE WHEN sqlc.arg('descending') THEN id END DESC,
+  sort_value ASC,
+  id ASC
+LIMIT ?;
+
 -- name: UpdateDeck :exec
 UPDATE decks
 SET 
@@ -41,7 +76,9 @@ SET
   main_card_id = ?,
   main_card_type_id = ?,
   sub_card_id = ?,
-  sub_card_type_id = ?
+  sub_card_type_id = ?,
+  format = ?,
+  owner_id = ?
 WHERE id = ?;
 
 -- name: DeleteDeck :exec
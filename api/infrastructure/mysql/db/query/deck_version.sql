@@ -0,0 +1,24 @@
+-- name: CreateDeckVersion :execresult
+INSERT INTO deck_versions (
+  deck_id,
+  version,
+  name,
+  card_count,
+  snapshot
+) VALUES (
+  ?, ?, ?, ?, ?
+);
+
+-- name: FindLatestDeckVersion :one
+SELECT CAST(COALESCE(MAX(version), 0) AS SIGNED) AS version FROM deck_versions
+WHERE deck_id = ?;
+
+-- name: FindDeckVersionsByDeckId :many
+SELECT * FROM deck_versions
+WHERE deck_id = ?
+ORDER BY version DESC;
+
+-- name: FindDeckVersion :one
+SELECT * FROM deck_versions
+WHERE deck_id = ? AND version = ?
+LIMIT 1;
@@ -2,3 +2,32 @@
 SELECT *
 FROM energies
 WHERE id = ?;
+
+-- name: EnergyFindByIds :many
+SELECT * FROM energies
+WHERE id IN (sqlc.slice('ids'));
+
+-- name: SearchEnergiesByFulltext :many
+SELECT sqlc.embed(energies), COUNT(*) OVER () AS total_hits
+FROM energies
+WHERE MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE)
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  CASE WHEN sqlc.arg(sort) = 'relevance' THEN MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?;
+
+-- name: SearchEnergiesByName :many
+SELECT sqlc.embed(energies), COUNT(*) OVER () AS total_hits
+FROM energies
+WHERE name LIKE CONCAT('%', sqlc.arg(query), '%')
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  id DESC
+LIMIT ? OFFSET ?;
@@ -5,3 +5,40 @@ WHERE id = ? LIMIT 1;
 -- name: PokemonAttackFindByPokemonId :many
 SELECT * FROM pokemon_attacks
 WHERE pokemon_id = ?;
+
+-- name: PokemonFindByIds :many
+SELECT * FROM pokemons
+WHERE id IN (sqlc.slice('ids'));
+
+-- name: SearchPokemonsByFulltext :many
+SELECT sqlc.embed(pokemons), COUNT(*) OVER () AS total_hits
+FROM pokemons
+WHERE MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE)
+  AND (sqlc.arg(all_energy_types) OR energy_type IN (sqlc.slice('energy_types')))
+  AND hp BETWEEN sqlc.arg(min_hp) AND sqlc.arg(max_hp)
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+  AND (sqlc.narg(has_ability) IS NULL OR (COALESCE(ability, '') <> '') = sqlc.narg(has_ability))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  CASE WHEN sqlc.arg(sort) = 'hp' THEN hp END DESC,
+  CASE WHEN sqlc.arg(sort) = 'relevance' THEN MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?;
+
+-- name: SearchPokemonsByName :many
+SELECT sqlc.embed(pokemons), COUNT(*) OVER () AS total_hits
+FROM pokemons
+WHERE name LIKE CONCAT('%', sqlc.arg(query), '%')
+  AND (sqlc.arg(all_energy_types) OR energy_type IN (sqlc.slice('energy_types')))
+  AND hp BETWEEN sqlc.arg(min_hp) AND sqlc.arg(max_hp)
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+  AND (sqlc.narg(has_ability) IS NULL OR (COALESCE(ability, '') <> '') = sqlc.narg(has_ability))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  CASE WHEN sqlc.arg(sort) = 'hp' THEN hp END DESC,
+  id DESC
+LIMIT ? OFFSET ?;
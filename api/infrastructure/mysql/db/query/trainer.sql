@@ -1,3 +1,34 @@
 -- name: TrainerFindById :one
 SELECT * FROM trainers
 WHERE id = ? LIMIT 1;
+
+-- name: TrainerFindByIds :many
+SELECT * FROM trainers
+WHERE id IN (sqlc.slice('ids'));
+
+-- name: SearchTrainersByFulltext :many
+SELECT sqlc.embed(trainers), COUNT(*) OVER () AS total_hits
+FROM trainers
+WHERE MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE)
+  AND (sqlc.arg(all_trainer_types) OR trainer_type IN (sqlc.slice('trainer_types')))
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  CASE WHEN sqlc.arg(sort) = 'relevance' THEN MATCH(name) AGAINST (sqlc.arg(query) IN BOOLEAN MODE) END DESC,
+  id DESC
+LIMIT ? OFFSET ?;
+
+-- name: SearchTrainersByName :many
+SELECT sqlc.embed(trainers), COUNT(*) OVER () AS total_hits
+FROM trainers
+WHERE name LIKE CONCAT('%', sqlc.arg(query), '%')
+  AND (sqlc.arg(all_trainer_types) OR trainer_type IN (sqlc.slice('trainer_types')))
+  AND (sqlc.arg(all_regulations) OR regulation IN (sqlc.slice('regulations')))
+  AND (sqlc.arg(all_expansions) OR expansion IN (sqlc.slice('expansions')))
+  AND (sqlc.narg(ace_spec) IS NULL OR ace_spec = sqlc.narg(ace_spec))
+ORDER BY
+  CASE WHEN sqlc.arg(sort) = 'name' THEN name END,
+  id DESC
+LIMIT ? OFFSET ?;
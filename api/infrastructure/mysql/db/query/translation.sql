@@ -0,0 +1,3 @@
+-- name: FindTranslations :many
+SELECT kind, source, text FROM translations
+WHERE lang = sqlc.arg(lang) AND source IN (sqlc.slice('sources'));
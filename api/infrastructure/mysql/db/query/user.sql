@@ -0,0 +1,19 @@
+-- name: CreateUser :exec
+INSERT INTO users (
+  id,
+  email,
+  name,
+  password_hash
+) VALUES (
+  ?, ?, ?, ?
+);
+
+-- name: FindUserByEmail :one
+SELECT * FROM users
+WHERE email = ?
+LIMIT 1;
+
+-- name: FindUserById :one
+SELECT * FROM users
+WHERE id = ?
+LIMIT 1;
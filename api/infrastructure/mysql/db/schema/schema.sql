@@ -8,8 +8,19 @@ CREATE TABLE IF NOT EXISTS `pokemons` (
   `ability_description` TEXT,
   `regulation` VARCHAR(16) NOT NULL,
   `expansion` VARCHAR(16) NOT NULL,
+  `card_number` VARCHAR(16) NOT NULL DEFAULT '',
+  `stage` VARCHAR(32),
+  `evolves_from` VARCHAR(255),
+  `ace_spec` BOOLEAN NOT NULL DEFAULT FALSE,
+  `radiant` BOOLEAN NOT NULL DEFAULT FALSE,
+  `prism_star` BOOLEAN NOT NULL DEFAULT FALSE,
+  `rule_box` BOOLEAN NOT NULL DEFAULT FALSE,
   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX `index_name` (`name`),
+  INDEX `index_expansion_card_number` (`expansion`, `card_number`),
+  INDEX `index_updated_at` (`updated_at`),
+  FULLTEXT INDEX `fulltext_name` (`name`) WITH PARSER ngram
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
 
 CREATE TABLE IF NOT EXISTS `pokemon_attacks` (
@@ -21,7 +32,8 @@ CREATE TABLE IF NOT EXISTS `pokemon_attacks` (
   `description` TEXT,
   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-  INDEX `index_pokemon_id` (`pokemon_id`)
+  INDEX `index_pokemon_id` (`pokemon_id`),
+  INDEX `index_updated_at` (`updated_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4; 
 
 CREATE TABLE IF NOT EXISTS `trainers` (
@@ -32,8 +44,15 @@ CREATE TABLE IF NOT EXISTS `trainers` (
   `description` TEXT NOT NULL,
   `regulation` VARCHAR(16) NOT NULL,
   `expansion` VARCHAR(16) NOT NULL,
+  `card_number` VARCHAR(16) NOT NULL DEFAULT '',
+  `ace_spec` BOOLEAN NOT NULL DEFAULT FALSE,
+  `prism_star` BOOLEAN NOT NULL DEFAULT FALSE,
   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX `index_name` (`name`),
+  INDEX `index_expansion_card_number` (`expansion`, `card_number`),
+  INDEX `index_updated_at` (`updated_at`),
+  FULLTEXT INDEX `fulltext_name` (`name`) WITH PARSER ngram
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
 
 CREATE TABLE IF NOT EXISTS `energies` (
@@ -43,11 +62,37 @@ CREATE TABLE IF NOT EXISTS `energies` (
   `description` TEXT NOT NULL,
   `regulation` VARCHAR(16) NOT NULL,
   `expansion` VARCHAR(16) NOT NULL,
+  `card_number` VARCHAR(16) NOT NULL DEFAULT '',
+  `ace_spec` BOOLEAN NOT NULL DEFAULT FALSE,
+  `prism_star` BOOLEAN NOT NULL DEFAULT FALSE,
   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX `index_name` (`name`),
+  INDEX `index_expansion_card_number` (`expansion`, `card_number`),
+  INDEX `index_updated_at` (`updated_at`),
+  FULLTEXT INDEX `fulltext_name` (`name`) WITH PARSER ngram
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
 
 
+CREATE TABLE IF NOT EXISTS `translations` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind` VARCHAR(32) NOT NULL,
+  `source` VARCHAR(255) NOT NULL,
+  `lang` VARCHAR(8) NOT NULL,
+  `text` VARCHAR(255) NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  UNIQUE INDEX `index_kind_source_lang` (`kind`, `source`, `lang`),
+  INDEX `index_lang_source` (`lang`, `source`),
+  INDEX `index_updated_at` (`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `search_index_watermarks` (
+  `index_uid` VARCHAR(64) NOT NULL PRIMARY KEY,
+  `indexed_until` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
+
 CREATE TABLE IF NOT EXISTS `card_types` (
   `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
   `name` VARCHAR(255) NOT NULL,
@@ -55,6 +100,30 @@ CREATE TABLE IF NOT EXISTS `card_types` (
   `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
 
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` CHAR(36) NOT NULL PRIMARY KEY,
+  `email` VARCHAR(255) NOT NULL,
+  `name` VARCHAR(255) NOT NULL,
+  `password_hash` VARCHAR(255) NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  UNIQUE KEY `unique_email` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `api_keys` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` CHAR(36) NOT NULL,
+  `name` VARCHAR(255) NOT NULL,
+  `prefix` VARCHAR(16) NOT NULL,
+  `key_hash` CHAR(64) NOT NULL,
+  `scopes` VARCHAR(255) NOT NULL,
+  `revoked_at` TIMESTAMP NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY `unique_key_hash` (`key_hash`),
+  INDEX `index_user_id` (`user_id`),
+  FOREIGN KEY (`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
+
 CREATE TABLE IF NOT EXISTS `decks` (
   `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
   `name` VARCHAR(255) NOT NULL,
@@ -63,8 +132,12 @@ CREATE TABLE IF NOT EXISTS `decks` (
   `main_card_type_id` BIGINT,
   `sub_card_id` BIGINT,
   `sub_card_type_id` BIGINT,
+  `format` VARCHAR(32) NOT NULL DEFAULT 'unlimited',
+  `owner_id` CHAR(36),
   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+  `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX `index_owner_id` (`owner_id`),
+  FOREIGN KEY (`owner_id`) REFERENCES `users`(`id`) ON DELETE SET NULL
 ) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
 
 CREATE TABLE IF NOT EXISTS `deck_cards` (
@@ -78,4 +151,16 @@ CREATE TABLE IF NOT EXISTS `deck_cards` (
   INDEX `index_deck_id` (`deck_id`),
   INDEX `index_card_id_card_type_id` (`card_id`, `card_type_id`),
   FOREIGN KEY (`deck_id`) REFERENCES `decks` (`id`) ON DELETE CASCADE
-) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `deck_versions` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `deck_id` BIGINT NOT NULL,
+  `version` INT NOT NULL,
+  `name` VARCHAR(255) NOT NULL,
+  `card_count` INT NOT NULL DEFAULT 0,
+  `snapshot` JSON NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE INDEX `index_deck_id_version` (`deck_id`, `version`),
+  FOREIGN KEY (`deck_id`) REFERENCES `decks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET = utf8mb4;
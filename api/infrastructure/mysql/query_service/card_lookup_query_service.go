@@ -0,0 +1,111 @@
+package query_service
+
+import (
+	deckUseCase "api/application/deck"
+	"api/domain"
+	domainDeck "api/domain/deck"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+type cardLookupQueryService struct{}
+
+func NewCardLookupQueryService() deckUseCase.CardLookupQueryService {
+	return &cardLookupQueryService{}
+}
+
+func (s *cardLookupQueryService) FindByExpansionAndNumber(ctx context.Context, expansion string, cardNumber string) ([]deckUseCase.CardPrint, error) {
+	rows, err := db.GetQuery(ctx).FindCardsByExpansionAndNumber(ctx, dbgen.FindCardsByExpansionAndNumberParams{
+		Expansion:  expansion,
+		CardNumber: cardNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(r dbgen.FindCardsByExpansionAndNumberRow, _ int) deckUseCase.CardPrint {
+		return newCardPrint(r.ID, r.CardTypeID, r.Name, r.Expansion, r.CardNumber)
+	}), nil
+}
+
+func (s *cardLookupQueryService) FindByName(ctx context.Context, name string) ([]deckUseCase.CardPrint, error) {
+	rows, err := db.GetQuery(ctx).FindCardsByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(r dbgen.FindCardsByNameRow, _ int) deckUseCase.CardPrint {
+		return newCardPrint(r.ID, r.CardTypeID, r.Name, r.Expansion, r.CardNumber)
+	}), nil
+}
+
+func (s *cardLookupQueryService) SearchByName(ctx context.Context, name string, limit int) ([]deckUseCase.CardPrint, error) {
+	rows, err := db.GetQuery(ctx).SearchCardsByName(ctx, dbgen.SearchCardsByNameParams{
+		Name:  likeEscaper.Replace(name),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(r dbgen.SearchCardsByNameRow, _ int) deckUseCase.CardPrint {
+		return newCardPrint(r.ID, r.CardTypeID, r.Name, r.Expansion, r.CardNumber)
+	}), nil
+}
+
+// FindByIds タイプごとにIN句でまとめて取得する
+func (s *cardLookupQueryService) FindByIds(ctx context.Context, keys []domainDeck.CardKey) (map[domainDeck.CardKey]deckUseCase.CardPrint, error) {
+	query := db.GetQuery(ctx)
+
+	ids := map[domain.CardType][]int64{}
+	for _, k := range lo.Uniq(keys) {
+		ids[k.CardType] = append(ids[k.CardType], int64(k.Id))
+	}
+
+	var cards []deckUseCase.CardPrint
+	for cardType, cardIds := range ids {
+		switch cardType {
+		case domain.Pokemon:
+			rows, err := query.PokemonFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range rows {
+				cards = append(cards, newCardPrint(p.ID, int64(domain.Pokemon), p.Name, p.Expansion, p.CardNumber))
+			}
+		case domain.Trainer:
+			rows, err := query.TrainerFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range rows {
+				cards = append(cards, newCardPrint(t.ID, int64(domain.Trainer), t.Name, t.Expansion, t.CardNumber))
+			}
+		case domain.Energy:
+			rows, err := query.EnergyFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range rows {
+				cards = append(cards, newCardPrint(e.ID, int64(domain.Energy), e.Name, e.Expansion, e.CardNumber))
+			}
+		default:
+			return nil, errors.New("invalid card type")
+		}
+	}
+
+	return lo.SliceToMap(cards, func(c deckUseCase.CardPrint) (domainDeck.CardKey, deckUseCase.CardPrint) {
+		return domainDeck.CardKey{Id: c.Id, CardType: c.CardType}, c
+	}), nil
+}
+
+func newCardPrint(id int64, cardTypeId int64, name string, expansion string, cardNumber string) deckUseCase.CardPrint {
+	return deckUseCase.CardPrint{
+		Id:         int(id),
+		CardType:   domain.CardType(cardTypeId),
+		Name:       name,
+		Expansion:  expansion,
+		CardNumber: cardNumber,
+	}
+}
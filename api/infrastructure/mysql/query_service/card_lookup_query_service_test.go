@@ -0,0 +1,40 @@
+package query_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// カード番号を持たないカードは番号では見つからず、名前で見つかる
+func TestCardLookupQueryService_CardWithoutNumber(t *testing.T) {
+	setupFixtures(t)
+
+	qs := NewCardLookupQueryService()
+	got, err := qs.FindByExpansionAndNumber(context.Background(), "SM1+", "135")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	got, err = qs.FindByName(context.Background(), "ハイパーボール")
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "", got[0].CardNumber)
+	}
+}
+
+func TestCardLookupQueryService_SearchByName(t *testing.T) {
+	setupFixtures(t)
+
+	qs := NewCardLookupQueryService()
+	got, err := qs.SearchByName(context.Background(), "ピカチュウ", 10)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, got)
+
+	// ワイルドカードは文字としてだけ一致する
+	for _, name := range []string{"%", "_", "ピカ%ex", "ピカチュウ_x"} {
+		got, err := qs.SearchByName(context.Background(), name, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, got, name)
+	}
+}
@@ -0,0 +1,31 @@
+package query_service
+
+import (
+	"api/application/search/suggest"
+	"api/domain"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+
+	"github.com/samber/lo"
+)
+
+type cardNameQueryService struct{}
+
+func NewCardNameQueryService() suggest.CardNameQueryService {
+	return &cardNameQueryService{}
+}
+
+func (s *cardNameQueryService) ListCardNames(ctx context.Context) ([]suggest.CardName, error) {
+	rows, err := db.GetQuery(ctx).ListCardNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(r dbgen.ListCardNamesRow, _ int) suggest.CardName {
+		return suggest.CardName{
+			ID:       int(r.ID),
+			CardType: domain.CardTypeToString[domain.CardType(r.CardTypeID)],
+			Name:     r.Name,
+		}
+	}), nil
+}
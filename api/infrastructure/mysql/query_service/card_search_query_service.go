@@ -0,0 +1,224 @@
+package query_service
+
+import (
+	"api/application/search/energy"
+	"api/application/search/facet"
+	"api/application/search/pokemon"
+	"api/application/search/trainer"
+	"api/infrastructure/meilisearch/query_service/util"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+)
+
+// ngramの区切りの長さ(ngram_token_size)。これより短いキーワードはFULLTEXTインデックスで探せない
+const ngramTokenSize = 2
+
+// Meilisearchが使えないときに、カード名のFULLTEXTインデックスで検索する
+type pokemonSearchQueryService struct{}
+
+func NewPokemonSearchQueryService() pokemon.PokemonQueryService {
+	return &pokemonSearchQueryService{}
+}
+
+func (s *pokemonSearchQueryService) SearchPokemonList(ctx context.Context, q pokemon.SearchPokemonQuery) (*pokemon.SearchPokemonResult, error) {
+	query := db.GetQuery(ctx)
+	keyword, fulltext := searchKeyword(q.Q)
+	params := dbgen.SearchPokemonsByNameParams{
+		Query:          keyword,
+		AllEnergyTypes: len(q.EnergyTypes) == 0,
+		EnergyTypes:    q.EnergyTypes,
+		MinHp:          int64(q.MinHp),
+		MaxHp:          maxHp(q.MaxHp),
+		AllRegulations: len(q.Regulations) == 0,
+		Regulations:    q.Regulations,
+		AllExpansions:  len(q.Expansions) == 0,
+		Expansions:     q.Expansions,
+		AceSpec:        nullBool(q.AceSpec),
+		HasAbility:     nullBool(q.HasAbility),
+		Sort:           string(q.Page.Sort),
+		Limit:          int32(q.Page.Limit),
+		Offset:         int32(q.Page.Offset),
+	}
+
+	// 件数は結果のすべての行に付いている
+	var rows []dbgen.Pokemon
+	var totalHits int64
+	if fulltext {
+		result, err := query.SearchPokemonsByFulltext(ctx, dbgen.SearchPokemonsByFulltextParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Pokemon), r.TotalHits
+		}
+	} else {
+		result, err := query.SearchPokemonsByName(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Pokemon), r.TotalHits
+		}
+	}
+
+	// 一覧ではワザを表示しないので読み込まない
+	pokemons := lo.Map(rows, func(p dbgen.Pokemon, _ int) *pokemon.SearchPokemonList {
+		return &pokemon.SearchPokemonList{
+			ID:          int(p.ID),
+			Name:        p.Name,
+			EnergyType:  p.EnergyType,
+			Hp:          int(p.Hp),
+			ImageURL:    p.ImageUrl,
+			Stage:       p.Stage.String,
+			EvolvesFrom: p.EvolvesFrom.String,
+			Attacks:     []pokemon.PokemonAttackResult{},
+		}
+	})
+
+	// 絞り込み条件の値ごとの件数はMeilisearchでしか数えない
+	return &pokemon.SearchPokemonResult{Pokemons: pokemons, Facets: facet.Empty(), EstimatedTotalHits: totalHits}, nil
+}
+
+type trainerSearchQueryService struct{}
+
+func NewTrainerSearchQueryService() trainer.TrainerQueryService {
+	return &trainerSearchQueryService{}
+}
+
+func (s *trainerSearchQueryService) SearchTrainerList(ctx context.Context, q trainer.SearchTrainerQuery) (*trainer.SearchTrainerResult, error) {
+	query := db.GetQuery(ctx)
+	keyword, fulltext := searchKeyword(q.Q)
+	params := dbgen.SearchTrainersByNameParams{
+		Query:           keyword,
+		AllTrainerTypes: len(q.TrainerTypes) == 0,
+		TrainerTypes:    q.TrainerTypes,
+		AllRegulations:  len(q.Regulations) == 0,
+		Regulations:     q.Regulations,
+		AllExpansions:   len(q.Expansions) == 0,
+		Expansions:      q.Expansions,
+		AceSpec:         nullBool(q.AceSpec),
+		Sort:            string(q.Page.Sort),
+		Limit:           int32(q.Page.Limit),
+		Offset:          int32(q.Page.Offset),
+	}
+
+	var rows []dbgen.Trainer
+	var totalHits int64
+	if fulltext {
+		result, err := query.SearchTrainersByFulltext(ctx, dbgen.SearchTrainersByFulltextParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Trainer), r.TotalHits
+		}
+	} else {
+		result, err := query.SearchTrainersByName(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Trainer), r.TotalHits
+		}
+	}
+
+	trainers := lo.Map(rows, func(t dbgen.Trainer, _ int) *trainer.SearchTrainerList {
+		return &trainer.SearchTrainerList{
+			ID:          int(t.ID),
+			Name:        t.Name,
+			TrainerType: t.TrainerType,
+			ImageURL:    t.ImageUrl,
+		}
+	})
+
+	return &trainer.SearchTrainerResult{Trainers: trainers, Facets: facet.Empty(), EstimatedTotalHits: totalHits}, nil
+}
+
+type energySearchQueryService struct{}
+
+func NewEnergySearchQueryService() energy.EnergyQueryService {
+	return &energySearchQueryService{}
+}
+
+func (s *energySearchQueryService) SearchEnergyList(ctx context.Context, q energy.SearchEnergyQuery) (*energy.SearchEnergyResult, error) {
+	query := db.GetQuery(ctx)
+	keyword, fulltext := searchKeyword(q.Q)
+	params := dbgen.SearchEnergiesByNameParams{
+		Query:          keyword,
+		AllRegulations: len(q.Regulations) == 0,
+		Regulations:    q.Regulations,
+		AllExpansions:  len(q.Expansions) == 0,
+		Expansions:     q.Expansions,
+		AceSpec:        nullBool(q.AceSpec),
+		Sort:           string(q.Page.Sort),
+		Limit:          int32(q.Page.Limit),
+		Offset:         int32(q.Page.Offset),
+	}
+
+	// 件数は結果のすべての行に付いている
+	var rows []dbgen.Energy
+	var totalHits int64
+	if fulltext {
+		result, err := query.SearchEnergiesByFulltext(ctx, dbgen.SearchEnergiesByFulltextParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Energy), r.TotalHits
+		}
+	} else {
+		result, err := query.SearchEnergiesByName(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			rows, totalHits = append(rows, r.Energy), r.TotalHits
+		}
+	}
+
+	energies := lo.Map(rows, func(e dbgen.Energy, _ int) *energy.SearchEnergyList {
+		return &energy.SearchEnergyList{
+			ID:          int(e.ID),
+			Name:        e.Name,
+			ImageURL:    e.ImageUrl,
+			Description: e.Description,
+		}
+	})
+
+	return &energy.SearchEnergyResult{Energies: energies, Facets: facet.Empty(), EstimatedTotalHits: totalHits}, nil
+}
+
+// maxHp 0は上限なし
+func maxHp(v int) int64 {
+	if v <= 0 {
+		return math.MaxInt32
+	}
+	return int64(v)
+}
+
+func nullBool(v *bool) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *v, Valid: true}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchKeyword Meilisearchと同じようにひらがなをカタカナにそろえる
+// FULLTEXTインデックスで探せる長さなら、語順どおりに含むものだけがヒットするようにフレーズ検索にする
+// 短いキーワードや空の場合はLIKEで探す
+func searchKeyword(q string) (string, bool) {
+	q = strings.TrimSpace(util.HiraganaToKatakana(q))
+	if utf8.RuneCountInString(q) < ngramTokenSize {
+		return likeEscaper.Replace(q), false
+	}
+	return `"` + strings.ReplaceAll(q, `"`, "") + `"`, true
+}
@@ -61,6 +61,8 @@ func (s *detailQueryService) FindPokemonDetail(ctx context.Context, pokemonId in
 		ImageUrl:           p.ImageUrl,
 		Regulation:         p.Regulation,
 		Expansion:          p.Expansion,
+		Stage:              p.Stage.String,
+		EvolvesFrom:        p.EvolvesFrom.String,
 		Attacks:            pokemonAttacks,
 	}
 
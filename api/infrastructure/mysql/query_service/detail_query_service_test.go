@@ -30,6 +30,8 @@ func TestDetailQueryService_FindPokemonDetail(t *testing.T) {
 		ImageUrl:           "pika.png",
 		Regulation:         "",
 		Expansion:          "",
+		Stage:              "たね",
+		EvolvesFrom:        "",
 		Attacks:            attacks,
 	}
 	tests := map[string]struct {
@@ -0,0 +1,33 @@
+package query_service
+
+import (
+	"api/application/translation"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+
+	"github.com/samber/lo"
+)
+
+type translationQueryService struct{}
+
+func NewTranslationQueryService() translation.TranslationQueryService {
+	return &translationQueryService{}
+}
+
+func (s *translationQueryService) FindTranslations(ctx context.Context, lang translation.Lang, sources []string) ([]translation.Translation, error) {
+	rows, err := db.GetQuery(ctx).FindTranslations(ctx, dbgen.FindTranslationsParams{
+		Lang:    string(lang),
+		Sources: sources,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(r dbgen.FindTranslationsRow, _ int) translation.Translation {
+		return translation.Translation{
+			Kind:   translation.Kind(r.Kind),
+			Source: r.Source,
+			Text:   r.Text,
+		}
+	}), nil
+}
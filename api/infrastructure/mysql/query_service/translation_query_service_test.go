@@ -0,0 +1,25 @@
+package query_service
+
+import (
+	"api/application/translation"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslationQueryService_FindTranslations(t *testing.T) {
+	setupFixtures(t)
+
+	qs := NewTranslationQueryService()
+	got, err := qs.FindTranslations(context.Background(), translation.En, []string{"ピカチュウex", "雷", "基本雷エネルギー"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []translation.Translation{
+		{Kind: translation.CardName, Source: "ピカチュウex", Text: "Pikachu ex"},
+		{Kind: translation.Label, Source: "雷", Text: "Lightning"},
+	}, got)
+
+	got, err = qs.FindTranslations(context.Background(), translation.Ja, []string{"ピカチュウex"})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"api/domain/apikey"
+	domainErr "api/domain/error"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type apiKeyRepository struct{}
+
+// ApiKeyRepositoryインターフェースの実装
+func NewApiKeyRepository() apikey.ApiKeyRepository {
+	return &apiKeyRepository{}
+}
+
+// APIキーの作成
+func (r *apiKeyRepository) Create(ctx context.Context, k *apikey.ApiKey) (*apikey.ApiKey, error) {
+	query := db.GetQuery(ctx)
+
+	scopes := make([]string, 0, len(k.GetScopes()))
+	for _, s := range k.GetScopes() {
+		scopes = append(scopes, string(s))
+	}
+
+	result, err := query.CreateApiKey(ctx, dbgen.CreateApiKeyParams{
+		UserID:  k.GetUserId(),
+		Name:    k.GetName(),
+		Prefix:  k.GetPrefix(),
+		KeyHash: k.GetKeyHash(),
+		Scopes:  strings.Join(scopes, ","),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("APIキー作成エラー: %w", err)
+	}
+
+	insertedId, _ := result.LastInsertId()
+	row, err := query.FindApiKeyById(ctx, insertedId)
+	if err != nil {
+		return nil, fmt.Errorf("APIキー取得エラー: %w", err)
+	}
+	return toApiKey(row)
+}
+
+// キーのハッシュからAPIキーを取得
+func (r *apiKeyRepository) FindByHash(ctx context.Context, keyHash string) (*apikey.ApiKey, error) {
+	query := db.GetQuery(ctx)
+
+	row, err := query.FindApiKeyByHash(ctx, keyHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("APIキーが見つかりません: %w", domainErr.NotFoundErr)
+		}
+		return nil, fmt.Errorf("APIキー取得エラー: %w", err)
+	}
+	return toApiKey(row)
+}
+
+// ユーザーのAPIキー一覧取得
+func (r *apiKeyRepository) FindByUserId(ctx context.Context, userId string) ([]*apikey.ApiKey, error) {
+	query := db.GetQuery(ctx)
+
+	rows, err := query.FindApiKeysByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("APIキー一覧取得エラー: %w", err)
+	}
+
+	var keys []*apikey.ApiKey
+	for _, row := range rows {
+		k, err := toApiKey(row)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// APIキーの無効化
+func (r *apiKeyRepository) Revoke(ctx context.Context, id int, userId string) error {
+	query := db.GetQuery(ctx)
+
+	result, err := query.RevokeApiKey(ctx, dbgen.RevokeApiKeyParams{
+		ID:     int64(id),
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("APIキー無効化エラー: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return fmt.Errorf("APIキーが見つかりません: %w", domainErr.NotFoundErr)
+	}
+	return nil
+}
+
+func toApiKey(row dbgen.ApiKey) (*apikey.ApiKey, error) {
+	scopes, err := apikey.ParseScopes(strings.Split(row.Scopes, ","))
+	if err != nil {
+		return nil, fmt.Errorf("APIキーのスコープ読み込みエラー: %w", err)
+	}
+
+	var revokedAt *time.Time
+	if row.RevokedAt.Valid {
+		revokedAt = &row.RevokedAt.Time
+	}
+	return apikey.NewApiKey(int(row.ID), row.UserID, row.Name, row.Prefix, row.KeyHash, scopes, revokedAt, row.CreatedAt)
+}
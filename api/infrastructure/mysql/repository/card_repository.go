@@ -8,6 +8,7 @@ import (
 	"api/domain/pokemon"
 	"api/domain/trainer"
 	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
 	"context"
 	"database/sql"
 	"errors"
@@ -31,25 +32,7 @@ func (r *cardRepository) FindCardById(ctx context.Context, cardId int, cardType
 			}
 			return nil, err
 		}
-
-		p, err := pokemon.NewPokemon(
-			int(pokemonRow.ID),
-			pokemonRow.Name,
-			pokemonRow.EnergyType,
-			int(pokemonRow.Hp),
-			pokemonRow.Ability.String,
-			pokemonRow.AbilityDescription.String,
-			pokemonRow.ImageUrl,
-			pokemonRow.Regulation,
-			pokemonRow.Expansion,
-			nil, // デッキ情報としてワザは不要
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		return p, nil
-
+		return toPokemon(pokemonRow)
 	case domain.Trainer:
 		// エネルギーカードとトレーナーカードが逆になっていたため修正
 		trainerRow, err := query.TrainerFindById(ctx, int64(cardId))
@@ -59,37 +42,186 @@ func (r *cardRepository) FindCardById(ctx context.Context, cardId int, cardType
 			}
 			return nil, err
 		}
-
-		t, err := trainer.NewTrainer(
-			int(trainerRow.ID),
-			trainerRow.Name,
-			trainerRow.TrainerType,
-			trainerRow.Description,
-			trainerRow.ImageUrl,
-			trainerRow.Regulation,
-			trainerRow.Expansion,
-		)
-
-		return t, err
+		return toTrainer(trainerRow)
 	case domain.Energy:
 		// エネルギーカードとトレーナーカードが逆になっていたため修正
-		energyCard, err := query.EnergyFindById(ctx, int64(cardId))
+		energyRow, err := query.EnergyFindById(ctx, int64(cardId))
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, domainErr.NotFoundErr
 			}
 			return nil, err
 		}
-		e, err := energy.NewEnergy(
-			int(energyCard.ID),
-			energyCard.Name,
-			energyCard.ImageUrl,
-			energyCard.Regulation,
-			energyCard.Expansion,
-		)
-
-		return e, err
+		return toEnergy(energyRow)
 	default:
 		return nil, errors.New("invalid card type")
 	}
 }
+
+// 複数のカードをタイプごとにまとめて取得
+func (r *cardRepository) FindCardsByIds(ctx context.Context, keys []deck.CardKey) (map[deck.CardKey]domain.Card, error) {
+	cardKeys := make([]cardKey, 0, len(keys))
+	for _, k := range keys {
+		cardKeys = append(cardKeys, cardKey{id: int64(k.Id), cardType: k.CardType})
+	}
+
+	cards, err := findCardsByKeys(ctx, db.GetQuery(ctx), cardKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[deck.CardKey]domain.Card, len(cards))
+	for k, card := range cards {
+		result[deck.CardKey{Id: int(k.id), CardType: k.cardType}] = card
+	}
+	return result, nil
+}
+
+// findCards デッキの読み込みで使うカード情報を、注入されたCardRepositoryからまとめて取得する
+func findCards(ctx context.Context, cardRepository deck.CardRepository, keys []cardKey) (map[cardKey]domain.Card, error) {
+	deckKeys := make([]deck.CardKey, 0, len(keys))
+	for _, k := range keys {
+		deckKeys = append(deckKeys, deck.CardKey{Id: int(k.id), CardType: k.cardType})
+	}
+
+	found, err := cardRepository.FindCardsByIds(ctx, deckKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make(map[cardKey]domain.Card, len(found))
+	for k, card := range found {
+		cards[cardKey{id: int64(k.Id), cardType: k.CardType}] = card
+	}
+	return cards, nil
+}
+
+// cardKey カードはタイプごとにテーブルが分かれているので、IDとタイプの組で識別する
+type cardKey struct {
+	id       int64
+	cardType domain.CardType
+}
+
+// findCardsByKeys 複数のカードをタイプごとにIN句でまとめて取得する
+// 発行するクエリはカードの枚数に関係なくタイプごとに最大1回
+func findCardsByKeys(ctx context.Context, query *dbgen.Queries, keys []cardKey) (map[cardKey]domain.Card, error) {
+	ids := map[domain.CardType][]int64{}
+	seen := map[cardKey]bool{}
+	for _, k := range keys {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		ids[k.cardType] = append(ids[k.cardType], k.id)
+	}
+
+	cards := make(map[cardKey]domain.Card, len(seen))
+	for cardType, cardIds := range ids {
+		switch cardType {
+		case domain.Pokemon:
+			rows, err := query.PokemonFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range rows {
+				p, err := toPokemon(row)
+				if err != nil {
+					return nil, err
+				}
+				cards[cardKey{id: row.ID, cardType: domain.Pokemon}] = p
+			}
+		case domain.Trainer:
+			rows, err := query.TrainerFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range rows {
+				t, err := toTrainer(row)
+				if err != nil {
+					return nil, err
+				}
+				cards[cardKey{id: row.ID, cardType: domain.Trainer}] = t
+			}
+		case domain.Energy:
+			rows, err := query.EnergyFindByIds(ctx, cardIds)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range rows {
+				e, err := toEnergy(row)
+				if err != nil {
+					return nil, err
+				}
+				cards[cardKey{id: row.ID, cardType: domain.Energy}] = e
+			}
+		default:
+			return nil, errors.New("invalid card type")
+		}
+	}
+	return cards, nil
+}
+
+func toPokemon(row dbgen.Pokemon) (domain.Card, error) {
+	p, err := pokemon.NewPokemon(
+		int(row.ID),
+		row.Name,
+		row.EnergyType,
+		int(row.Hp),
+		row.Ability.String,
+		row.AbilityDescription.String,
+		row.ImageUrl,
+		row.Regulation,
+		row.Expansion,
+		row.Stage.String,
+		row.EvolvesFrom.String,
+		nil, // デッキ情報としてワザは不要
+		domain.SpecialRules{
+			AceSpec:   row.AceSpec,
+			Radiant:   row.Radiant,
+			PrismStar: row.PrismStar,
+			RuleBox:   row.RuleBox,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func toTrainer(row dbgen.Trainer) (domain.Card, error) {
+	t, err := trainer.NewTrainer(
+		int(row.ID),
+		row.Name,
+		row.TrainerType,
+		row.Description,
+		row.ImageUrl,
+		row.Regulation,
+		row.Expansion,
+		domain.SpecialRules{
+			AceSpec:   row.AceSpec,
+			PrismStar: row.PrismStar,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func toEnergy(row dbgen.Energy) (domain.Card, error) {
+	e, err := energy.NewEnergy(
+		int(row.ID),
+		row.Name,
+		row.ImageUrl,
+		row.Regulation,
+		row.Expansion,
+		domain.SpecialRules{
+			AceSpec:   row.AceSpec,
+			PrismStar: row.PrismStar,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -3,12 +3,15 @@ package repository
 import (
 	"api/domain"
 	"api/domain/deck"
+	domainErr "api/domain/error"
 	"api/infrastructure/mysql/db"
 	"api/infrastructure/mysql/db/dbgen"
 	"context"
 	"database/sql"
-	"errors"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type deckRepository struct {
@@ -16,10 +19,9 @@ type deckRepository struct {
 }
 
 // DeckRepositoryインターフェースの実装
-func NewDeckRepository() deck.DeckRepository {
-	return &deckRepository{
-		cardRepository: NewCardRepository(),
-	}
+// カード情報はcardRepositoryから取得するので、キャッシュするCardRepositoryを渡せばデッキの読み込みでもキャッシュを使う
+func NewDeckRepository(cardRepository deck.CardRepository) deck.DeckRepository {
+	return &deckRepository{cardRepository: cardRepository}
 }
 
 // デッキの作成
@@ -61,6 +63,8 @@ func (r *deckRepository) Create(ctx context.Context, d *deck.Deck) (*deck.Deck,
 		MainCardTypeID: mainCardTypeID,
 		SubCardID:      subCardID,
 		SubCardTypeID:  subCardTypeID,
+		Format:         d.GetFormat().GetName(),
+		OwnerID:        sql.NullString{String: d.GetOwnerId(), Valid: d.GetOwnerId() != ""},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("デッキ作成エラー: %w", err)
@@ -82,6 +86,11 @@ func (r *deckRepository) Create(ctx context.Context, d *deck.Deck) (*deck.Deck,
 		}
 	}
 
+	// 最初のバージョンとして保存
+	if err := createDeckVersion(ctx, qtx, insertedId, newDeckSnapshot(d)); err != nil {
+		return nil, err
+	}
+
 	// トランザクションをコミット
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("トランザクションコミットエラー: %w", err)
@@ -91,27 +100,91 @@ func (r *deckRepository) Create(ctx context.Context, d *deck.Deck) (*deck.Deck,
 	return r.FindById(ctx, int(insertedId))
 }
 
-// ユーザーのデッキ一覧取得
-func (r *deckRepository) FindAll(ctx context.Context) ([]*deck.Deck, error) {
+// 条件に合うデッキを1ページ分取得
+func (r *deckRepository) FindPage(ctx context.Context, condition deck.ListCondition) (*deck.DeckPage, error) {
 	query := db.GetQuery(ctx)
 
-	// ユーザーのデッキ一覧を取得
-	deckRows, err := query.FindALl(ctx)
+	rows, nextCursor, err := findDeckPageRows(ctx, query, condition)
 	if err != nil {
-		return nil, fmt.Errorf("デッキ一覧取得エラー: %w", err)
+		return nil, err
 	}
 
-	var decks []*deck.Deck
-	for _, row := range deckRows {
-		// 個別のデッキを取得
-		deck, err := r.FindById(ctx, int(row.ID))
+	deckRows := make([]dbgen.Deck, 0, len(rows))
+	for _, row := range rows {
+		deckRows = append(deckRows, dbgen.Deck{
+			ID:             row.ID,
+			Name:           row.Name,
+			Description:    row.Description,
+			MainCardID:     row.MainCardID,
+			MainCardTypeID: row.MainCardTypeID,
+			SubCardID:      row.SubCardID,
+			SubCardTypeID:  row.SubCardTypeID,
+			Format:         row.Format,
+			OwnerID:        row.OwnerID,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		})
+	}
+
+	decks, err := r.loadDecks(ctx, query, deckRows)
+	if err != nil {
+		return nil, err
+	}
+	return &deck.DeckPage{Decks: decks, NextCursor: nextCursor}, nil
+}
+
+// 一覧表示用の概要を1ページ分取得。デッキカードは読み込まず、枚数だけを集計する
+func (r *deckRepository) FindSummaryPage(ctx context.Context, condition deck.ListCondition) (*deck.DeckSummaryPage, error) {
+	query := db.GetQuery(ctx)
+
+	rows, nextCursor, err := findDeckPageRows(ctx, query, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []cardKey
+	for _, row := range rows {
+		if row.MainCardID.Valid && row.MainCardTypeID.Valid {
+			keys = append(keys, cardKey{id: row.MainCardID.Int64, cardType: domain.CardType(row.MainCardTypeID.Int64)})
+		}
+		if row.SubCardID.Valid && row.SubCardTypeID.Valid {
+			keys = append(keys, cardKey{id: row.SubCardID.Int64, cardType: domain.CardType(row.SubCardTypeID.Int64)})
+		}
+	}
+	cards, err := findCards(ctx, r.cardRepository, keys)
+	if err != nil {
+		return nil, fmt.Errorf("カード取得エラー: %w", err)
+	}
+
+	summaries := make([]*deck.DeckSummary, 0, len(rows))
+	for _, row := range rows {
+		var mainCard domain.Card
+		var subCard domain.Card
+		if row.MainCardID.Valid && row.MainCardTypeID.Valid {
+			mainCard = cards[cardKey{id: row.MainCardID.Int64, cardType: domain.CardType(row.MainCardTypeID.Int64)}]
+		}
+		if row.SubCardID.Valid && row.SubCardTypeID.Valid {
+			subCard = cards[cardKey{id: row.SubCardID.Int64, cardType: domain.CardType(row.SubCardTypeID.Int64)}]
+		}
+
+		format, err := deck.FindFormat(row.Format)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("フォーマット取得エラー: %w", err)
 		}
-		decks = append(decks, deck)
+
+		summaries = append(summaries, deck.NewDeckSummary(
+			int(row.ID),
+			row.OwnerID.String,
+			row.Name,
+			row.Description.String,
+			format,
+			mainCard,
+			subCard,
+			int(row.CardCount),
+		))
 	}
 
-	return decks, nil
+	return &deck.DeckSummaryPage{Summaries: summaries, NextCursor: nextCursor}, nil
 }
 
 // デッキの詳細取得
@@ -122,66 +195,111 @@ func (r *deckRepository) FindById(ctx context.Context, id int) (*deck.Deck, erro
 	deckRow, err := query.FindDeckById(ctx, int64(id))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("デッキが見つかりません")
+			return nil, fmt.Errorf("デッキが見つかりません: %w", domainErr.NotFoundErr)
 		}
 		return nil, fmt.Errorf("デッキ取得エラー: %w", err)
 	}
 
+	decks, err := r.loadDecks(ctx, query, []dbgen.Deck{deckRow})
+	if err != nil {
+		return nil, err
+	}
+	return decks[0], nil
+}
+
+// loadDecks デッキの行からデッキを組み立てる
+// デッキカードとカード情報はIN句でまとめて取得するので、デッキの数に関係なくクエリは最大4回
+func (r *deckRepository) loadDecks(ctx context.Context, query *dbgen.Queries, deckRows []dbgen.Deck) ([]*deck.Deck, error) {
+	if len(deckRows) == 0 {
+		return nil, nil
+	}
+
+	deckIds := make([]int64, 0, len(deckRows))
+	for _, row := range deckRows {
+		deckIds = append(deckIds, row.ID)
+	}
+
 	// デッキカードを取得
-	deckCardRows, err := query.FindDeckCardsByDeckId(ctx, deckRow.ID)
+	deckCardRows, err := query.FindDeckCardsByDeckIds(ctx, deckIds)
 	if err != nil {
 		return nil, fmt.Errorf("デッキカード取得エラー: %w", err)
 	}
+	cardRowsByDeck := map[int64][]dbgen.DeckCard{}
+	for _, row := range deckCardRows {
+		cardRowsByDeck[row.DeckID] = append(cardRowsByDeck[row.DeckID], row)
+	}
 
-	// メインカード、サブカード、デッキカードをドメインオブジェクトに変換
-	var mainCard domain.Card
-	var subCard domain.Card
-	var deckCards []deck.DeckCard
-
-	// メインカードがある場合
-	if deckRow.MainCardID.Valid && deckRow.MainCardTypeID.Valid {
-		card, err := r.cardRepository.FindCardById(ctx, int(deckRow.MainCardID.Int64), domain.CardType(deckRow.MainCardTypeID.Int64))
-		if err != nil {
-			return nil, fmt.Errorf("メインカード取得エラー: %w", err)
+	// メインカード、サブカード、デッキカードをまとめて取得
+	var keys []cardKey
+	for _, row := range deckRows {
+		if row.MainCardID.Valid && row.MainCardTypeID.Valid {
+			keys = append(keys, cardKey{id: row.MainCardID.Int64, cardType: domain.CardType(row.MainCardTypeID.Int64)})
 		}
-		mainCard = card
+		if row.SubCardID.Valid && row.SubCardTypeID.Valid {
+			keys = append(keys, cardKey{id: row.SubCardID.Int64, cardType: domain.CardType(row.SubCardTypeID.Int64)})
+		}
+	}
+	for _, row := range deckCardRows {
+		keys = append(keys, cardKey{id: row.CardID, cardType: domain.CardType(row.CardTypeID)})
+	}
+	cards, err := findCards(ctx, r.cardRepository, keys)
+	if err != nil {
+		return nil, fmt.Errorf("カード取得エラー: %w", err)
 	}
 
-	// サブカードがある場合
-	if deckRow.SubCardID.Valid && deckRow.SubCardTypeID.Valid {
-		card, err := r.cardRepository.FindCardById(ctx, int(deckRow.SubCardID.Int64), domain.CardType(deckRow.SubCardTypeID.Int64))
-		if err != nil {
-			return nil, fmt.Errorf("サブカード取得エラー: %w", err)
+	decks := make([]*deck.Deck, 0, len(deckRows))
+	for _, deckRow := range deckRows {
+		// メインカード、サブカード、デッキカードをドメインオブジェクトに変換
+		var mainCard domain.Card
+		var subCard domain.Card
+		var deckCards []deck.DeckCard
+
+		// メインカードがある場合
+		if deckRow.MainCardID.Valid && deckRow.MainCardTypeID.Valid {
+			card, ok := cards[cardKey{id: deckRow.MainCardID.Int64, cardType: domain.CardType(deckRow.MainCardTypeID.Int64)}]
+			if !ok {
+				return nil, fmt.Errorf("メインカード取得エラー: %w", domainErr.NotFoundErr)
+			}
+			mainCard = card
 		}
-		subCard = card
-	}
 
-	// デッキカードを変換
-	for _, cardRow := range deckCardRows {
-		card, err := r.cardRepository.FindCardById(ctx, int(cardRow.CardID), domain.CardType(cardRow.CardTypeID))
-		if err != nil {
-			return nil, fmt.Errorf("カード取得エラー: %w", err)
+		// サブカードがある場合
+		if deckRow.SubCardID.Valid && deckRow.SubCardTypeID.Valid {
+			card, ok := cards[cardKey{id: deckRow.SubCardID.Int64, cardType: domain.CardType(deckRow.SubCardTypeID.Int64)}]
+			if !ok {
+				return nil, fmt.Errorf("サブカード取得エラー: %w", domainErr.NotFoundErr)
+			}
+			subCard = card
 		}
 
-		deckCard := deck.NewDeckCard(card, int(cardRow.Quantity))
-		deckCards = append(deckCards, *deckCard)
-	}
+		// デッキカードを変換
+		for _, cardRow := range cardRowsByDeck[deckRow.ID] {
+			card, ok := cards[cardKey{id: cardRow.CardID, cardType: domain.CardType(cardRow.CardTypeID)}]
+			if !ok {
+				return nil, fmt.Errorf("カード取得エラー: %w", domainErr.NotFoundErr)
+			}
+			deckCards = append(deckCards, *deck.NewDeckCard(card, int(cardRow.Quantity)))
+		}
 
-	// デッキオブジェクトを作成（バリデーションをスキップ）
-	description := ""
-	if deckRow.Description.Valid {
-		description = deckRow.Description.String
+		format, err := deck.FindFormat(deckRow.Format)
+		if err != nil {
+			return nil, fmt.Errorf("フォーマット取得エラー: %w", err)
+		}
+
+		// データベースから読み込むときはバリデーションをスキップ
+		decks = append(decks, deck.NewDeckWithoutValidation(
+			int(deckRow.ID),
+			deckRow.OwnerID.String,
+			deckRow.Name,
+			deckRow.Description.String,
+			format,
+			mainCard,
+			subCard,
+			deckCards,
+		))
 	}
 
-	// データベースから読み込むときはバリデーションをスキップ
-	return deck.NewDeckWithoutValidation(
-		int(deckRow.ID),
-		deckRow.Name,
-		description,
-		mainCard,
-		subCard,
-		deckCards,
-	), nil
+	return decks, nil
 }
 
 // デッキの更新
@@ -215,6 +333,17 @@ func (r *deckRepository) Update(ctx context.Context, d *deck.Deck) error {
 		subCardTypeID.Valid = true
 	}
 
+	// 履歴機能より前に作られたデッキは、上書きする前の内容を最初のバージョンとして残す
+	latestVersion, err := qtx.FindLatestDeckVersion(ctx, int64(d.GetId()))
+	if err != nil {
+		return fmt.Errorf("デッキバージョン取得エラー: %w", err)
+	}
+	if latestVersion == 0 {
+		if err := r.createInitialVersion(ctx, qtx, int64(d.GetId())); err != nil {
+			return err
+		}
+	}
+
 	// デッキを更新
 	err = qtx.UpdateDeck(ctx, dbgen.UpdateDeckParams{
 		ID:             int64(d.GetId()),
@@ -224,6 +353,8 @@ func (r *deckRepository) Update(ctx context.Context, d *deck.Deck) error {
 		MainCardTypeID: mainCardTypeID,
 		SubCardID:      subCardID,
 		SubCardTypeID:  subCardTypeID,
+		Format:         d.GetFormat().GetName(),
+		OwnerID:        sql.NullString{String: d.GetOwnerId(), Valid: d.GetOwnerId() != ""},
 	})
 	if err != nil {
 		return fmt.Errorf("デッキ更新エラー: %w", err)
@@ -248,6 +379,10 @@ func (r *deckRepository) Update(ctx context.Context, d *deck.Deck) error {
 		}
 	}
 
+	if err := createDeckVersion(ctx, qtx, int64(d.GetId()), newDeckSnapshot(d)); err != nil {
+		return err
+	}
+
 	// トランザクションをコミット
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("トランザクションコミットエラー: %w", err)
@@ -256,6 +391,19 @@ func (r *deckRepository) Update(ctx context.Context, d *deck.Deck) error {
 	return nil
 }
 
+// createInitialVersion 更新前に保存されているデッキの内容をバージョンとして保存する
+func (r *deckRepository) createInitialVersion(ctx context.Context, qtx *dbgen.Queries, deckId int64) error {
+	deckRow, err := qtx.FindDeckById(ctx, deckId)
+	if err != nil {
+		return fmt.Errorf("デッキ取得エラー: %w", err)
+	}
+	cardRows, err := qtx.FindDeckCardsByDeckId(ctx, deckId)
+	if err != nil {
+		return fmt.Errorf("デッキカード取得エラー: %w", err)
+	}
+	return createDeckVersion(ctx, qtx, deckId, newDeckSnapshotFromRows(deckRow, cardRows))
+}
+
 // デッキの削除
 func (r *deckRepository) Delete(ctx context.Context, id int) error {
 	query := db.GetQuery(ctx)
@@ -268,3 +416,80 @@ func (r *deckRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// deckCursor ページの最後のデッキの並び順の値とID。別の並び順で発行されたカーソルは使えない
+type deckCursor struct {
+	SortKey    string `json:"s"`
+	Descending bool   `json:"d"`
+	Value      string `json:"v"`
+	ID         int64  `json:"id"`
+}
+
+func encodeDeckCursor(c deckCursor) string {
+	body, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+func decodeDeckCursor(s string, condition deck.ListCondition) (deckCursor, error) {
+	var c deckCursor
+	body, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, deck.ErrInvalidCursor
+	}
+	if err := json.Unmarshal(body, &c); err != nil || c.ID <= 0 {
+		return c, deck.ErrInvalidCursor
+	}
+	if c.SortKey != string(condition.SortKey) || c.Descending != condition.Descending {
+		return c, deck.ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// LIKEのワイルドカードとして扱われないようにエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// findDeckPageRows 1件多く取得して、次のページがあるかを判定する
+func findDeckPageRows(ctx context.Context, query *dbgen.Queries, condition deck.ListCondition) ([]dbgen.FindDeckPageRow, string, error) {
+	params := dbgen.FindDeckPageParams{
+		SortKey:        string(condition.SortKey),
+		OwnerID:        sql.NullString{String: condition.OwnerId, Valid: true},
+		IncludeUnowned: condition.IncludeUnowned,
+		Name:           likeEscaper.Replace(condition.Name),
+		Format:         condition.Format,
+		Descending:     condition.Descending,
+		Limit:          int32(condition.Limit + 1),
+	}
+	if condition.MainCard != nil {
+		params.MainCardID = int64(condition.MainCard.Id)
+		params.MainCardTypeID = int64(condition.MainCard.CardType)
+	}
+	if condition.Card != nil {
+		params.CardID = int64(condition.Card.Id)
+		params.CardTypeID = int64(condition.Card.CardType)
+	}
+	if condition.Cursor != "" {
+		cursor, err := decodeDeckCursor(condition.Cursor, condition)
+		if err != nil {
+			return nil, "", err
+		}
+		params.CursorID = cursor.ID
+		params.CursorValue = cursor.Value
+	}
+
+	rows, err := query.FindDeckPage(ctx, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("デッキ一覧取得エラー: %w", err)
+	}
+
+	if len(rows) <= condition.Limit {
+		return rows, "", nil
+	}
+	rows = rows[:condition.Limit]
+	last := rows[len(rows)-1]
+	return rows, encodeDeckCursor(deckCursor{
+		SortKey:    string(condition.SortKey),
+		Descending: condition.Descending,
+		Value:      last.SortValue,
+		ID:         last.ID,
+	}), nil
+}
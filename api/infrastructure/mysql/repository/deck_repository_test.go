@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"api/domain"
+	"api/domain/deck"
+	"api/domain/user"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+)
+
+// countingDB 発行したクエリの回数を数える
+type countingDB struct {
+	dbgen.DBTX
+	queries int64
+}
+
+func (c *countingDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	atomic.AddInt64(&c.queries, 1)
+	return c.DBTX.ExecContext(ctx, query, args...)
+}
+
+func (c *countingDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	atomic.AddInt64(&c.queries, 1)
+	return c.DBTX.QueryContext(ctx, query, args...)
+}
+
+func (c *countingDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	atomic.AddInt64(&c.queries, 1)
+	return c.DBTX.QueryRowContext(ctx, query, args...)
+}
+
+func (c *countingDB) reset() {
+	atomic.StoreInt64(&c.queries, 0)
+}
+
+func (c *countingDB) count() int64 {
+	return atomic.LoadInt64(&c.queries)
+}
+
+// useCountingDB 以降のクエリをcountingDB経由で発行する
+func useCountingDB(tb testing.TB) *countingDB {
+	counter := &countingDB{DBTX: db.GetDB()}
+	db.SetQuery(dbgen.New(counter))
+	tb.Cleanup(func() {
+		db.SetQuery(dbgen.New(db.GetDB()))
+	})
+	return counter
+}
+
+// createTestDecks ユーザーを作成し、fixturesのカードを使ったデッキをn個保存する
+func createTestDecks(tb testing.TB, n int) string {
+	ctx := context.Background()
+
+	u, err := user.NewUser(uuid.NewString(), fmt.Sprintf("%s@example.com", uuid.NewString()), "テストユーザー", "hash")
+	if err != nil {
+		tb.Fatalf("failed to create user: %v", err)
+	}
+	if err := NewUserRepository().Create(ctx, u); err != nil {
+		tb.Fatalf("failed to save user: %v", err)
+	}
+
+	cardRepository := NewCardRepository()
+	pikachu, err := cardRepository.FindCardById(ctx, 1, domain.Pokemon)
+	if err != nil {
+		tb.Fatalf("failed to find pokemon: %v", err)
+	}
+	ultraBall, err := cardRepository.FindCardById(ctx, 1, domain.Trainer)
+	if err != nil {
+		tb.Fatalf("failed to find trainer: %v", err)
+	}
+	lightning, err := cardRepository.FindCardById(ctx, 1, domain.Energy)
+	if err != nil {
+		tb.Fatalf("failed to find energy: %v", err)
+	}
+
+	format, _ := deck.FindFormat(deck.FormatStandard)
+	deckRepository := NewDeckRepository(NewCardRepository())
+	for i := 0; i < n; i++ {
+		d := deck.NewDeckWithoutValidation(0, u.GetId(), fmt.Sprintf("デッキ%d", i), "", format, pikachu, ultraBall, []deck.DeckCard{
+			*deck.NewDeckCard(pikachu, 4),
+			*deck.NewDeckCard(ultraBall, 4),
+			*deck.NewDeckCard(lightning, 52),
+		})
+		if _, err := deckRepository.Create(ctx, d); err != nil {
+			tb.Fatalf("failed to create deck: %v", err)
+		}
+	}
+	return u.GetId()
+}
+
+func pageCondition(ownerId string, limit int) deck.ListCondition {
+	return deck.ListCondition{
+		OwnerId:    ownerId,
+		SortKey:    deck.SortByCreatedAt,
+		Descending: true,
+		Limit:      limit,
+	}
+}
+
+func TestDeckRepository_FindPage(t *testing.T) {
+	setupFixtures(t)
+	ownerId := createTestDecks(t, 3)
+	ctx := context.Background()
+	repository := NewDeckRepository(NewCardRepository())
+
+	page, err := repository.FindPage(ctx, pageCondition(ownerId, 10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Decks) != 3 || page.NextCursor != "" {
+		t.Fatalf("expected 3 decks without next cursor, got %d decks, cursor %q", len(page.Decks), page.NextCursor)
+	}
+	for _, d := range page.Decks {
+		if d.GetOwnerId() != ownerId {
+			t.Errorf("expected owner %s, got %s", ownerId, d.GetOwnerId())
+		}
+		if d.GetMainCard() == nil || d.GetMainCard().GetName() != "ピカチュウex" {
+			t.Errorf("unexpected main card: %v", d.GetMainCard())
+		}
+		if d.GetSubCard() == nil || d.GetSubCard().GetName() != "ハイパーボール" {
+			t.Errorf("unexpected sub card: %v", d.GetSubCard())
+		}
+		if len(d.GetCards()) != 3 {
+			t.Errorf("expected 3 deck cards, got %d", len(d.GetCards()))
+		}
+	}
+
+	// 2件ずつ取得して、すべてのデッキを重複なく辿れること
+	condition := pageCondition(ownerId, 2)
+	var names []string
+	for i := 0; i < 3; i++ {
+		page, err := repository.FindPage(ctx, condition)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, d := range page.Decks {
+			names = append(names, d.GetName())
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		condition.Cursor = page.NextCursor
+	}
+	if fmt.Sprint(names) != "[デッキ2 デッキ1 デッキ0]" {
+		t.Errorf("unexpected pages: %v", names)
+	}
+
+	// 別の並び順で発行されたカーソルは使えない
+	condition.SortKey = deck.SortByName
+	if _, err := repository.FindPage(ctx, condition); err != deck.ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestDeckRepository_FindPage_IncludeUnowned(t *testing.T) {
+	setupFixtures(t)
+	ownerId := createTestDecks(t, 1)
+	ctx := context.Background()
+	repository := NewDeckRepository(NewCardRepository())
+
+	// アカウント導入前に作成されたデッキ
+	name := fmt.Sprintf("持ち主なし-%s", uuid.NewString())
+	format, _ := deck.FindFormat(deck.FormatStandard)
+	if _, err := repository.Create(ctx, deck.NewDeckWithoutValidation(0, "", name, "", format, nil, nil, nil)); err != nil {
+		t.Fatalf("failed to create deck: %v", err)
+	}
+
+	for _, includeUnowned := range []bool{false, true} {
+		condition := pageCondition(ownerId, 10)
+		condition.Name = name
+		condition.IncludeUnowned = includeUnowned
+		page, err := repository.FindPage(ctx, condition)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if found := len(page.Decks) == 1 && page.Decks[0].GetOwnerId() == ""; found != includeUnowned {
+			t.Errorf("include_unowned=%v: unexpected decks %v", includeUnowned, page.Decks)
+		}
+	}
+}
+
+// recordingCardRepository 問い合わせのあったカードを記録する
+type recordingCardRepository struct {
+	deck.CardRepository
+	keys []deck.CardKey
+}
+
+func (r *recordingCardRepository) FindCardsByIds(ctx context.Context, keys []deck.CardKey) (map[deck.CardKey]domain.Card, error) {
+	r.keys = append(r.keys, keys...)
+	return r.CardRepository.FindCardsByIds(ctx, keys)
+}
+
+func TestDeckRepository_FindById_UsesCardRepository(t *testing.T) {
+	setupFixtures(t)
+	ownerId := createTestDecks(t, 1)
+	ctx := context.Background()
+
+	page, err := NewDeckRepository(NewCardRepository()).FindPage(ctx, pageCondition(ownerId, 1))
+	if err != nil || len(page.Decks) != 1 {
+		t.Fatalf("failed to find deck: %v", err)
+	}
+
+	cardRepository := &recordingCardRepository{CardRepository: NewCardRepository()}
+	d, err := NewDeckRepository(cardRepository).FindById(ctx, page.Decks[0].GetId())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	requested := map[deck.CardKey]bool{}
+	for _, key := range cardRepository.keys {
+		requested[key] = true
+	}
+	for _, c := range d.GetCards() {
+		key := deck.CardKey{Id: c.GetCard().GetId(), CardType: domain.CardType(c.GetCard().GetCardType())}
+		if !requested[key] {
+			t.Errorf("card %v was not loaded through the card repository", key)
+		}
+	}
+}
+
+func TestDeckRepository_FindSummaryPage(t *testing.T) {
+	setupFixtures(t)
+	ownerId := createTestDecks(t, 3)
+
+	condition := pageCondition(ownerId, 10)
+	condition.Name = "デッキ1"
+	condition.Format = deck.FormatStandard
+	condition.Card = &deck.CardFilter{Id: 1, CardType: domain.Energy}
+	page, err := NewDeckRepository(NewCardRepository()).FindSummaryPage(context.Background(), condition)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Summaries) != 1 {
+		t.Fatalf("expected 1 deck, got %d", len(page.Summaries))
+	}
+	summary := page.Summaries[0]
+	if summary.GetName() != "デッキ1" || summary.GetCardCount() != 60 {
+		t.Errorf("unexpected summary: %s, %d cards", summary.GetName(), summary.GetCardCount())
+	}
+	if summary.GetMainCard() == nil || summary.GetMainCard().GetName() != "ピカチュウex" {
+		t.Errorf("unexpected main card: %v", summary.GetMainCard())
+	}
+}
+
+// デッキの数が増えても発行するクエリの回数が変わらないことを確認する
+func BenchmarkDeckRepository_FindPage(b *testing.B) {
+	if err := fixtures.Load(); err != nil {
+		b.Fatalf("failed to load fixtures: %v", err)
+	}
+
+	var want int64
+	for _, n := range []int{1, 10, 50} {
+		ownerId := createTestDecks(b, n)
+
+		b.Run(fmt.Sprintf("decks=%d", n), func(b *testing.B) {
+			counter := useCountingDB(b)
+			repository := NewDeckRepository(NewCardRepository())
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				counter.reset()
+				page, err := repository.FindPage(ctx, pageCondition(ownerId, n))
+				if err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+				if len(page.Decks) != n {
+					b.Fatalf("expected %d decks, got %d", n, len(page.Decks))
+				}
+			}
+			b.StopTimer()
+
+			got := counter.count()
+			b.ReportMetric(float64(got), "queries/op")
+			if want == 0 {
+				want = got
+			}
+			if got != want {
+				b.Fatalf("query count changed with %d decks: got %d, want %d", n, got, want)
+			}
+		})
+	}
+}
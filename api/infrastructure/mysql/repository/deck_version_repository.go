@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"api/domain"
+	"api/domain/deck"
+	domainErr "api/domain/error"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// deck_versions.snapshotに保存するJSON。カードはIDだけを持ち、読み込むときに最新のカード情報で復元する
+type deckSnapshot struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Format      string             `json:"format"`
+	MainCard    *snapshotCard      `json:"main_card,omitempty"`
+	SubCard     *snapshotCard      `json:"sub_card,omitempty"`
+	Cards       []snapshotDeckCard `json:"cards"`
+}
+
+type snapshotCard struct {
+	CardID     int64 `json:"card_id"`
+	CardTypeID int64 `json:"card_type_id"`
+}
+
+type snapshotDeckCard struct {
+	CardID     int64 `json:"card_id"`
+	CardTypeID int64 `json:"card_type_id"`
+	Quantity   int32 `json:"quantity"`
+}
+
+type deckVersionRepository struct {
+	cardRepository deck.CardRepository
+}
+
+// DeckVersionRepositoryインターフェースの実装
+func NewDeckVersionRepository(cardRepository deck.CardRepository) deck.DeckVersionRepository {
+	return &deckVersionRepository{cardRepository: cardRepository}
+}
+
+// バージョン一覧取得
+func (r *deckVersionRepository) FindByDeckId(ctx context.Context, deckId int) ([]*deck.DeckVersion, error) {
+	query := db.GetQuery(ctx)
+
+	rows, err := query.FindDeckVersionsByDeckId(ctx, int64(deckId))
+	if err != nil {
+		return nil, fmt.Errorf("デッキバージョン一覧取得エラー: %w", err)
+	}
+
+	var versions []*deck.DeckVersion
+	for _, row := range rows {
+		versions = append(versions, toDeckVersion(row))
+	}
+	return versions, nil
+}
+
+// 指定したバージョンのデッキを取得
+func (r *deckVersionRepository) FindByVersion(ctx context.Context, deckId int, version int) (*deck.DeckVersion, *deck.Deck, error) {
+	query := db.GetQuery(ctx)
+
+	row, err := query.FindDeckVersion(ctx, dbgen.FindDeckVersionParams{
+		DeckID:  int64(deckId),
+		Version: int32(version),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("デッキバージョンが見つかりません: %w", domainErr.NotFoundErr)
+		}
+		return nil, nil, fmt.Errorf("デッキバージョン取得エラー: %w", err)
+	}
+
+	var snapshot deckSnapshot
+	if err := json.Unmarshal(row.Snapshot, &snapshot); err != nil {
+		return nil, nil, fmt.Errorf("スナップショット読み込みエラー: %w", err)
+	}
+
+	// スナップショットのカードをまとめて取得
+	var keys []cardKey
+	if snapshot.MainCard != nil {
+		keys = append(keys, cardKey{id: snapshot.MainCard.CardID, cardType: domain.CardType(snapshot.MainCard.CardTypeID)})
+	}
+	if snapshot.SubCard != nil {
+		keys = append(keys, cardKey{id: snapshot.SubCard.CardID, cardType: domain.CardType(snapshot.SubCard.CardTypeID)})
+	}
+	for _, c := range snapshot.Cards {
+		keys = append(keys, cardKey{id: c.CardID, cardType: domain.CardType(c.CardTypeID)})
+	}
+	cards, err := findCards(ctx, r.cardRepository, keys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("カード取得エラー: %w", err)
+	}
+
+	var mainCard domain.Card
+	var subCard domain.Card
+	var deckCards []deck.DeckCard
+
+	if snapshot.MainCard != nil {
+		card, ok := cards[cardKey{id: snapshot.MainCard.CardID, cardType: domain.CardType(snapshot.MainCard.CardTypeID)}]
+		if !ok {
+			return nil, nil, fmt.Errorf("メインカード取得エラー: %w", domainErr.NotFoundErr)
+		}
+		mainCard = card
+	}
+
+	if snapshot.SubCard != nil {
+		card, ok := cards[cardKey{id: snapshot.SubCard.CardID, cardType: domain.CardType(snapshot.SubCard.CardTypeID)}]
+		if !ok {
+			return nil, nil, fmt.Errorf("サブカード取得エラー: %w", domainErr.NotFoundErr)
+		}
+		subCard = card
+	}
+
+	for _, c := range snapshot.Cards {
+		card, ok := cards[cardKey{id: c.CardID, cardType: domain.CardType(c.CardTypeID)}]
+		if !ok {
+			return nil, nil, fmt.Errorf("カード取得エラー: %w", domainErr.NotFoundErr)
+		}
+		deckCards = append(deckCards, *deck.NewDeckCard(card, int(c.Quantity)))
+	}
+
+	format, err := deck.FindFormat(snapshot.Format)
+	if err != nil {
+		return nil, nil, fmt.Errorf("フォーマット取得エラー: %w", err)
+	}
+
+	return toDeckVersion(row), deck.NewDeckWithoutValidation(
+		deckId, "",
+		snapshot.Name,
+		snapshot.Description,
+		format,
+		mainCard,
+		subCard,
+		deckCards,
+	), nil
+}
+
+func toDeckVersion(row dbgen.DeckVersion) *deck.DeckVersion {
+	return deck.NewDeckVersion(int(row.DeckID), int(row.Version), row.Name, int(row.CardCount), row.CreatedAt)
+}
+
+// createDeckVersion デッキの作成・更新と同じトランザクションで次のバージョンを保存する
+func createDeckVersion(ctx context.Context, qtx *dbgen.Queries, deckId int64, snapshot deckSnapshot) error {
+	latest, err := qtx.FindLatestDeckVersion(ctx, deckId)
+	if err != nil {
+		return fmt.Errorf("デッキバージョン取得エラー: %w", err)
+	}
+
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("スナップショット作成エラー: %w", err)
+	}
+
+	cardCount := 0
+	for _, c := range snapshot.Cards {
+		cardCount += int(c.Quantity)
+	}
+
+	_, err = qtx.CreateDeckVersion(ctx, dbgen.CreateDeckVersionParams{
+		DeckID:    deckId,
+		Version:   int32(latest + 1),
+		Name:      snapshot.Name,
+		CardCount: int32(cardCount),
+		Snapshot:  body,
+	})
+	if err != nil {
+		return fmt.Errorf("デッキバージョン作成エラー: %w", err)
+	}
+	return nil
+}
+
+func newDeckSnapshot(d *deck.Deck) deckSnapshot {
+	snapshot := deckSnapshot{
+		Name:        d.GetName(),
+		Description: d.GetDescription(),
+		Format:      d.GetFormat().GetName(),
+		Cards:       []snapshotDeckCard{},
+	}
+	if d.GetMainCard() != nil {
+		snapshot.MainCard = &snapshotCard{CardID: int64(d.GetMainCard().GetId()), CardTypeID: int64(d.GetMainCard().GetCardType())}
+	}
+	if d.GetSubCard() != nil {
+		snapshot.SubCard = &snapshotCard{CardID: int64(d.GetSubCard().GetId()), CardTypeID: int64(d.GetSubCard().GetCardType())}
+	}
+	for _, c := range d.GetCards() {
+		snapshot.Cards = append(snapshot.Cards, snapshotDeckCard{
+			CardID:     int64(c.GetCard().GetId()),
+			CardTypeID: int64(c.GetCard().GetCardType()),
+			Quantity:   int32(c.GetQuantity()),
+		})
+	}
+	return snapshot
+}
+
+// newDeckSnapshotFromRows 履歴機能より前に作られたデッキを更新するとき、上書きされる前の内容を残すために使う
+func newDeckSnapshotFromRows(deckRow dbgen.Deck, cardRows []dbgen.DeckCard) deckSnapshot {
+	snapshot := deckSnapshot{
+		Name:        deckRow.Name,
+		Description: deckRow.Description.String,
+		Format:      deckRow.Format,
+		Cards:       []snapshotDeckCard{},
+	}
+	if deckRow.MainCardID.Valid && deckRow.MainCardTypeID.Valid {
+		snapshot.MainCard = &snapshotCard{CardID: deckRow.MainCardID.Int64, CardTypeID: deckRow.MainCardTypeID.Int64}
+	}
+	if deckRow.SubCardID.Valid && deckRow.SubCardTypeID.Valid {
+		snapshot.SubCard = &snapshotCard{CardID: deckRow.SubCardID.Int64, CardTypeID: deckRow.SubCardTypeID.Int64}
+	}
+	for _, c := range cardRows {
+		snapshot.Cards = append(snapshot.Cards, snapshotDeckCard{
+			CardID:     c.CardID,
+			CardTypeID: c.CardTypeID,
+			Quantity:   c.Quantity,
+		})
+	}
+	return snapshot
+}
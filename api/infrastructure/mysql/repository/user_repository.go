@@ -0,0 +1,72 @@
+package repository
+
+import (
+	domainErr "api/domain/error"
+	"api/domain/user"
+	"api/infrastructure/mysql/db"
+	"api/infrastructure/mysql/db/dbgen"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// 一意制約違反のエラー番号
+const mysqlErrDuplicateEntry = 1062
+
+type userRepository struct{}
+
+// UserRepositoryインターフェースの実装
+func NewUserRepository() user.UserRepository {
+	return &userRepository{}
+}
+
+// ユーザーの作成
+func (r *userRepository) Create(ctx context.Context, u *user.User) error {
+	query := db.GetQuery(ctx)
+
+	err := query.CreateUser(ctx, dbgen.CreateUserParams{
+		ID:           u.GetId(),
+		Email:        u.GetEmail(),
+		Name:         u.GetName(),
+		PasswordHash: u.GetPasswordHash(),
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+			return user.ErrEmailAlreadyExists
+		}
+		return fmt.Errorf("ユーザー作成エラー: %w", err)
+	}
+	return nil
+}
+
+// メールアドレスからユーザーを取得
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	query := db.GetQuery(ctx)
+
+	row, err := query.FindUserByEmail(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("ユーザーが見つかりません: %w", domainErr.NotFoundErr)
+		}
+		return nil, fmt.Errorf("ユーザー取得エラー: %w", err)
+	}
+	return user.NewUser(row.ID, row.Email, row.Name, row.PasswordHash)
+}
+
+// IDからユーザーを取得
+func (r *userRepository) FindById(ctx context.Context, id string) (*user.User, error) {
+	query := db.GetQuery(ctx)
+
+	row, err := query.FindUserById(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("ユーザーが見つかりません: %w", domainErr.NotFoundErr)
+		}
+		return nil, fmt.Errorf("ユーザー取得エラー: %w", err)
+	}
+	return user.NewUser(row.ID, row.Email, row.Name, row.PasswordHash)
+}
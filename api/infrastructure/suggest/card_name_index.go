@@ -0,0 +1,61 @@
+// Package suggest はカード名の入力補完に使う索引
+package suggest
+
+import (
+	suggestUseCase "api/application/search/suggest"
+	"api/pkg/normalize"
+	"api/pkg/trie"
+	"slices"
+	"sync/atomic"
+)
+
+// CardNameIndex カード名を正規化してトライ木に入れ、前方一致で探す
+// 作り直している間も前の索引で答えられるように、作り終えてから差し替える
+type CardNameIndex struct {
+	trie atomic.Pointer[trie.Trie[suggestUseCase.Suggestion]]
+}
+
+func NewCardNameIndex() *CardNameIndex {
+	i := &CardNameIndex{}
+	i.trie.Store(trie.New[suggestUseCase.Suggestion]())
+	return i
+}
+
+// Rebuild namesは新しいカードから順に並んでいる前提で、同じ名前は最初のカードのIDを使う
+func (i *CardNameIndex) Rebuild(names []suggestUseCase.CardName) int {
+	t := trie.New[suggestUseCase.Suggestion]()
+	for _, n := range names {
+		key := normalize.String(n.Name)
+		if key == "" {
+			continue
+		}
+		s, ok := t.Get(key)
+		if !ok {
+			s = suggestUseCase.Suggestion{ID: n.ID, CardType: n.CardType, Name: n.Name}
+		}
+		s.Prints++
+		t.Put(key, s)
+	}
+	i.trie.Store(t)
+	return t.Len()
+}
+
+// Suggest 入力途中の英字はローマ字か、リザードンexのexのような英字の語か分からないので、両方の読みで探す
+// 「リザードンe」をローマ字として読むと「リザドンエ」になり、リザードンexに前方一致しなくなる
+func (i *CardNameIndex) Suggest(prefix string, limit int) []suggestUseCase.Suggestion {
+	t := i.trie.Load()
+	var suggestions []suggestUseCase.Suggestion
+	seen := map[string]bool{}
+	for _, key := range []string{normalize.String(prefix), normalize.Latin(prefix)} {
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		for _, s := range t.WithPrefix(key, limit) {
+			if !slices.Contains(suggestions, s) && len(suggestions) < limit {
+				suggestions = append(suggestions, s)
+			}
+		}
+	}
+	return suggestions
+}
@@ -0,0 +1,59 @@
+package suggest
+
+import (
+	suggestUseCase "api/application/search/suggest"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardNameIndex(t *testing.T) {
+	index := NewCardNameIndex()
+	n := index.Rebuild([]suggestUseCase.CardName{
+		{ID: 30, CardType: "pokemon", Name: "リザードンex"},
+		{ID: 20, CardType: "pokemon", Name: "リザードン"},
+		{ID: 10, CardType: "pokemon", Name: "リザードンex"},
+		{ID: 5, CardType: "trainer", Name: "リーリエの決心"},
+	})
+
+	assert.Equal(t, 3, n)
+
+	// ひらがなでも探せて、再録は1件にまとめる
+	got := index.Suggest("りざーどん", 10)
+	assert.Equal(t, []suggestUseCase.Suggestion{
+		{ID: 20, CardType: "pokemon", Name: "リザードン", Prints: 1},
+		{ID: 30, CardType: "pokemon", Name: "リザードンex", Prints: 2},
+	}, got)
+
+	assert.Equal(t, "リザードンex", index.Suggest("リザードンEX", 10)[0].Name)
+	assert.Equal(t, "リザードン", index.Suggest("rizado", 10)[0].Name)
+	// 入力途中の英字はローマ字として読まなくても探す
+	assert.Equal(t, "リザードンex", index.Suggest("リザードンe", 10)[0].Name)
+	assert.Len(t, index.Suggest("リ", 1), 1)
+	assert.Empty(t, index.Suggest(" ", 10))
+}
+
+func TestCardNameIndex_RebuildReplaces(t *testing.T) {
+	index := NewCardNameIndex()
+	index.Rebuild([]suggestUseCase.CardName{{ID: 1, CardType: "trainer", Name: "ナンジャモ"}})
+	index.Rebuild([]suggestUseCase.CardName{{ID: 2, CardType: "trainer", Name: "ネモ"}})
+
+	assert.Empty(t, index.Suggest("ナ", 10))
+	assert.Equal(t, "ネモ", index.Suggest("ね", 10)[0].Name)
+}
+
+// 1文字目だけの入力がいちばん候補が多い
+func BenchmarkCardNameIndex_Suggest(b *testing.B) {
+	names := make([]suggestUseCase.CardName, 0, 20000)
+	for i := 0; i < 20000; i++ {
+		names = append(names, suggestUseCase.CardName{ID: i, CardType: "pokemon", Name: fmt.Sprintf("リザードン%d", i)})
+	}
+	index := NewCardNameIndex()
+	index.Rebuild(names)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Suggest("り", 10)
+	}
+}
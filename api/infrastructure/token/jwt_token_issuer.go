@@ -0,0 +1,38 @@
+package token
+
+import (
+	"api/application/auth"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type jwtTokenIssuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// TokenIssuerインターフェースの実装。ユーザーIDをsubに入れたHS256のJWTを発行する
+func NewJWTTokenIssuer(secret string, ttl time.Duration) auth.TokenIssuer {
+	return &jwtTokenIssuer{
+		secret: []byte(secret),
+		ttl:    ttl,
+	}
+}
+
+func (i *jwtTokenIssuer) Issue(userId string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(i.ttl)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   userId,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	})
+	signed, err := token.SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("トークン発行エラー: %w", err)
+	}
+	return signed, expiresAt, nil
+}
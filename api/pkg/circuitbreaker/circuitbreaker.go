@@ -0,0 +1,154 @@
+// Package circuitbreaker は失敗が続いている相手への呼び出しを一定時間止める
+//
+// 連続でthreshold回失敗すると開いた状態になり、cooldownの間は呼び出しを許可しない。
+// cooldownが過ぎると半開きの状態になり、1回だけ試しに呼び出す。成功すれば閉じ、失敗すればまた開く
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+)
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	// 半開きの状態で試しに呼び出している最中か
+	probing bool
+	// テストで時刻を差し替えるため
+	now func() time.Time
+}
+
+// New thresholdは開くまでの連続失敗回数、cooldownは開いてから試しに呼び出すまでの時間
+func New(threshold int, cooldown time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow 呼び出してよいか。許可された場合は、結果を Success・Failure・Cancel のどれかで必ず伝える
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case Closed:
+		return true
+	case HalfOpen:
+		if b.probing {
+			return false
+		}
+		b.state = HalfOpen
+		b.probing = true
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.close()
+}
+
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		// 開く前に許可した呼び出しの失敗で、cooldownを延ばさない
+		return
+	case HalfOpen:
+		b.probing = false
+		b.open()
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+// Cancel 許可した呼び出しが結果を得られずに終わったときに、成功とも失敗とも数えない
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// Trip ヘルスチェックで落ちていると分かったときに、失敗回数によらず開く
+func (b *Breaker) Trip() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	b.open()
+}
+
+// Reset ヘルスチェックで復旧したと分かったときに、cooldownを待たずに閉じる
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.close()
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.currentState()
+}
+
+// 開いた状態でもcooldownが過ぎていれば半開きとして扱う
+func (b *Breaker) currentState() State {
+	if b.state == Open && b.now().Sub(b.openedAt) >= b.cooldown {
+		return HalfOpen
+	}
+	return b.state
+}
+
+func (b *Breaker) open() {
+	b.state = Open
+	b.openedAt = b.now()
+	b.failures = 0
+}
+
+func (b *Breaker) close() {
+	b.state = Closed
+	b.failures = 0
+	b.probing = false
+}
@@ -0,0 +1,127 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestBreaker(threshold int, cooldown time.Duration) (*Breaker, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := New(threshold, cooldown)
+	b.now = clock.Now
+	return b, clock
+}
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newTestBreaker(3, 30*time.Second)
+
+	b.Failure()
+	b.Failure()
+	// 成功すると連続失敗回数は戻る
+	b.Success()
+	b.Failure()
+	b.Failure()
+	if !b.Allow() {
+		t.Fatal("expected breaker to stay closed below the threshold")
+	}
+
+	b.Failure()
+	if b.State() != Open {
+		t.Fatalf("expected open, got %v", b.State())
+	}
+	if b.Allow() {
+		t.Error("expected calls to be rejected while open")
+	}
+}
+
+func TestBreaker_HalfOpenAfterCooldown(t *testing.T) {
+	b, clock := newTestBreaker(1, 30*time.Second)
+	b.Failure()
+
+	clock.Advance(29 * time.Second)
+	if b.Allow() {
+		t.Fatal("expected calls to be rejected before the cooldown ends")
+	}
+
+	clock.Advance(time.Second)
+	if b.State() != HalfOpen {
+		t.Fatalf("expected half-open, got %v", b.State())
+	}
+	if !b.Allow() {
+		t.Fatal("expected one trial call after the cooldown")
+	}
+	if b.Allow() {
+		t.Error("expected only one trial call at a time")
+	}
+
+	// 試しの呼び出しが失敗したらまた開く
+	b.Failure()
+	if b.State() != Open || b.Allow() {
+		t.Fatalf("expected open after a failed trial, got %v", b.State())
+	}
+
+	clock.Advance(30 * time.Second)
+	if !b.Allow() {
+		t.Fatal("expected another trial call after the cooldown")
+	}
+	b.Success()
+	if b.State() != Closed || !b.Allow() {
+		t.Errorf("expected closed after a successful trial, got %v", b.State())
+	}
+}
+
+func TestBreaker_LateFailureDoesNotExtendCooldown(t *testing.T) {
+	b, clock := newTestBreaker(1, 30*time.Second)
+	b.Failure()
+
+	clock.Advance(20 * time.Second)
+	b.Failure()
+
+	clock.Advance(10 * time.Second)
+	if !b.Allow() {
+		t.Error("expected the cooldown to count from the first failure")
+	}
+}
+
+func TestBreaker_TripAndReset(t *testing.T) {
+	b, _ := newTestBreaker(5, time.Minute)
+
+	b.Trip()
+	if b.Allow() {
+		t.Fatal("expected calls to be rejected after Trip")
+	}
+
+	b.Reset()
+	if b.State() != Closed || !b.Allow() {
+		t.Errorf("expected closed after Reset, got %v", b.State())
+	}
+}
+
+func TestBreaker_CancelReleasesTrial(t *testing.T) {
+	b, clock := newTestBreaker(1, 30*time.Second)
+	b.Failure()
+	clock.Advance(30 * time.Second)
+
+	if !b.Allow() {
+		t.Fatal("expected a trial call after the cooldown")
+	}
+	b.Cancel()
+	if b.State() != HalfOpen {
+		t.Fatalf("expected a canceled trial to keep the breaker half-open, got %v", b.State())
+	}
+	if !b.Allow() {
+		t.Error("expected another trial call after a canceled one")
+	}
+}
@@ -0,0 +1,167 @@
+// Package deckcode はデッキのカード構成をURLに使える短い文字列に変換する
+//
+// バージョン1の形式(base64urlでエンコードする前のバイト列):
+//
+//	version(1byte) | count(uvarint) | { cardType(1byte) | idDelta(uvarint) | quantity(uvarint) } * count | crc32(4byte)
+//
+// カードはカードタイプ・IDの順に並べ、同じカードタイプの中ではIDを直前のカードとの差分で持つ。
+// 並び順を固定しているため、同じカード構成からは常に同じコードが作られる
+package deckcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+)
+
+const (
+	Version1 byte = 1
+	// 1つのデッキに入るカードの種類の上限。壊れたコードで大量のメモリを確保しないようにする
+	MaxEntries = 256
+	// 基本エネルギーを含めてもデッキの枚数を超えることはない
+	maxQuantity = 60
+	maxInt      = int(^uint(0) >> 1)
+)
+
+var (
+	ErrInvalidCode        = errors.New("invalid deck code")
+	ErrUnsupportedVersion = fmt.Errorf("%w: unsupported version", ErrInvalidCode)
+	ErrChecksumMismatch   = fmt.Errorf("%w: checksum mismatch", ErrInvalidCode)
+)
+
+type Entry struct {
+	CardType int
+	CardID   int
+	Quantity int
+}
+
+// Encode 同じカードが複数回含まれる場合は枚数をまとめてからエンコードする
+func Encode(entries []Entry) (string, error) {
+	merged, err := normalize(entries)
+	if err != nil {
+		return "", err
+	}
+
+	buf := []byte{Version1}
+	buf = binary.AppendUvarint(buf, uint64(len(merged)))
+	prevType, prevID := 0, 0
+	for _, e := range merged {
+		if e.CardType != prevType {
+			prevType, prevID = e.CardType, 0
+		}
+		buf = append(buf, byte(e.CardType))
+		buf = binary.AppendUvarint(buf, uint64(e.CardID-prevID))
+		buf = binary.AppendUvarint(buf, uint64(e.Quantity))
+		prevID = e.CardID
+	}
+
+	return base64.RawURLEncoding.EncodeToString(appendChecksum(buf)), nil
+}
+
+func appendChecksum(body []byte) []byte {
+	return binary.BigEndian.AppendUint32(body, crc32.ChecksumIEEE(body))
+}
+
+// Decode チェックサムとバージョンを確認してからカード構成を取り出す
+func Decode(code string) ([]Entry, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: not base64url", ErrInvalidCode)
+	}
+	// バージョン・件数・チェックサムで最低6バイト必要
+	if len(raw) < 6 {
+		return nil, fmt.Errorf("%w: too short", ErrInvalidCode)
+	}
+
+	body, sum := raw[:len(raw)-4], raw[len(raw)-4:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(sum) {
+		return nil, ErrChecksumMismatch
+	}
+	if body[0] != Version1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, body[0])
+	}
+
+	r := bytes.NewReader(body[1:])
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: broken card count", ErrInvalidCode)
+	}
+	if count == 0 || count > MaxEntries {
+		return nil, fmt.Errorf("%w: card count %d is out of range", ErrInvalidCode, count)
+	}
+
+	entries := make([]Entry, 0, count)
+	prevType, prevID := 0, 0
+	for i := uint64(0); i < count; i++ {
+		cardType, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: truncated at card %d", ErrInvalidCode, i+1)
+		}
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: truncated at card %d", ErrInvalidCode, i+1)
+		}
+		quantity, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: truncated at card %d", ErrInvalidCode, i+1)
+		}
+
+		if int(cardType) < prevType || cardType == 0 {
+			return nil, fmt.Errorf("%w: cards are not in canonical order", ErrInvalidCode)
+		}
+		if int(cardType) != prevType {
+			prevType, prevID = int(cardType), 0
+		}
+		// IDは1以上で、同じカードタイプの中では必ず増えていく
+		if delta == 0 || delta > uint64(maxInt-prevID) {
+			return nil, fmt.Errorf("%w: invalid card id at card %d", ErrInvalidCode, i+1)
+		}
+		if quantity == 0 || quantity > maxQuantity {
+			return nil, fmt.Errorf("%w: invalid quantity at card %d", ErrInvalidCode, i+1)
+		}
+
+		prevID += int(delta)
+		entries = append(entries, Entry{CardType: int(cardType), CardID: prevID, Quantity: int(quantity)})
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%w: unexpected trailing bytes", ErrInvalidCode)
+	}
+
+	return entries, nil
+}
+
+func normalize(entries []Entry) ([]Entry, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: no cards", ErrInvalidCode)
+	}
+
+	byCard := make(map[[2]int]int)
+	for _, e := range entries {
+		if e.CardType < 1 || e.CardType > 255 || e.CardID < 1 || e.Quantity < 1 {
+			return nil, fmt.Errorf("%w: invalid card %+v", ErrInvalidCode, e)
+		}
+		byCard[[2]int{e.CardType, e.CardID}] += e.Quantity
+	}
+	if len(byCard) > MaxEntries {
+		return nil, fmt.Errorf("%w: too many cards", ErrInvalidCode)
+	}
+
+	merged := make([]Entry, 0, len(byCard))
+	for key, quantity := range byCard {
+		if quantity > maxQuantity {
+			return nil, fmt.Errorf("%w: too many copies of card %d", ErrInvalidCode, key[1])
+		}
+		merged = append(merged, Entry{CardType: key[0], CardID: key[1], Quantity: quantity})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].CardType != merged[j].CardType {
+			return merged[i].CardType < merged[j].CardType
+		}
+		return merged[i].CardID < merged[j].CardID
+	})
+	return merged, nil
+}
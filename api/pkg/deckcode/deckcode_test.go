@@ -0,0 +1,95 @@
+package deckcode
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	entries := []Entry{
+		{CardType: 3, CardID: 5, Quantity: 10},
+		{CardType: 1, CardID: 120, Quantity: 4},
+		{CardType: 2, CardID: 7, Quantity: 4},
+		{CardType: 1, CardID: 3, Quantity: 2},
+		{CardType: 2, CardID: 7, Quantity: 1},
+	}
+
+	code, err := Encode(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// カードタイプ・IDの順に並び、同じカードはまとめられる
+	want := []Entry{
+		{CardType: 1, CardID: 3, Quantity: 2},
+		{CardType: 1, CardID: 120, Quantity: 4},
+		{CardType: 2, CardID: 7, Quantity: 5},
+		{CardType: 3, CardID: 5, Quantity: 10},
+	}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("got %+v, want %+v", decoded, want)
+	}
+
+	// 並び順が違っても同じコードになる
+	again, err := Encode(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != code {
+		t.Errorf("expected the same code, got %s and %s", code, again)
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	code, err := Encode([]Entry{{CardType: 1, CardID: 25, Quantity: 4}, {CardType: 3, CardID: 1, Quantity: 56}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := base64.RawURLEncoding.DecodeString(code)
+
+	tampered := append([]byte(nil), raw...)
+	tampered[3] ^= 0x01
+
+	tests := []struct {
+		name string
+		code string
+		want error
+	}{
+		{"not base64", "!!!", ErrInvalidCode},
+		{"too short", base64.RawURLEncoding.EncodeToString([]byte{1, 1}), ErrInvalidCode},
+		{"tampered", base64.RawURLEncoding.EncodeToString(tampered), ErrChecksumMismatch},
+		{"truncated", base64.RawURLEncoding.EncodeToString(raw[:len(raw)-1]), ErrChecksumMismatch},
+		{"unknown version", encodeRaw([]byte{9, 1, 1, 1, 1}), ErrUnsupportedVersion},
+		{"zero quantity", encodeRaw([]byte{Version1, 1, 1, 25, 0}), ErrInvalidCode},
+		{"duplicate card", encodeRaw([]byte{Version1, 2, 1, 25, 4, 1, 0, 4}), ErrInvalidCode},
+		{"missing cards", encodeRaw([]byte{Version1, 2, 1, 25, 4}), ErrInvalidCode},
+		{"trailing bytes", encodeRaw([]byte{Version1, 1, 1, 25, 4, 0}), ErrInvalidCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.code); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestEncode_Invalid(t *testing.T) {
+	if _, err := Encode(nil); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected ErrInvalidCode for an empty deck, got %v", err)
+	}
+	if _, err := Encode([]Entry{{CardType: 1, CardID: 0, Quantity: 1}}); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected ErrInvalidCode for card id 0, got %v", err)
+	}
+}
+
+// encodeRaw チェックサムだけ正しく付けたコードを作る
+func encodeRaw(body []byte) string {
+	return base64.RawURLEncoding.EncodeToString(appendChecksum(body))
+}
@@ -0,0 +1,177 @@
+// Package decklist はPTCG Liveなどで使われるテキスト形式のデッキリストを扱う
+//
+//	Pokémon: 12
+//	4 Pikachu ex SVI 57
+//
+//	Trainer: 36
+//	4 Iono PAL 185
+//
+//	Energy: 12
+//	12 Basic {L} Energy SVE 4
+//
+//	Total Cards: 60
+package decklist
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Section string
+
+const (
+	SectionPokemon Section = "pokemon"
+	SectionTrainer Section = "trainer"
+	SectionEnergy  Section = "energy"
+	// 見出しより前に書かれた行
+	SectionUnknown Section = ""
+)
+
+// 出力するときの見出し
+var sectionTitles = map[Section]string{
+	SectionPokemon: "Pokémon",
+	SectionTrainer: "Trainer",
+	SectionEnergy:  "Energy",
+}
+
+var sectionOrder = []Section{SectionPokemon, SectionTrainer, SectionEnergy}
+
+// 英語版と日本語版の見出しを受け付ける
+var sectionAliases = map[string]Section{
+	"pokémon":  SectionPokemon,
+	"pokemon":  SectionPokemon,
+	"ポケモン":     SectionPokemon,
+	"trainer":  SectionTrainer,
+	"trainers": SectionTrainer,
+	"トレーナー":    SectionTrainer,
+	"トレーナーズ":   SectionTrainer,
+	"グッズ":      SectionTrainer,
+	"energy":   SectionEnergy,
+	"エネルギー":    SectionEnergy,
+}
+
+type Entry struct {
+	Section  Section
+	Quantity int
+	Name     string
+	// エキスパンションのコードとカード番号。書かれていない場合は空になる
+	SetCode string
+	Number  string
+	// 入力の何行目か(1始まり)。出力には使わない
+	Line int
+}
+
+type ParseError struct {
+	Line int
+	Raw  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%d行目を読み取れません: %s", e.Line, e.Raw)
+}
+
+var (
+	headerPattern = regexp.MustCompile(`^(.+?)\s*[:：]\s*(\d+)?$`)
+	// 末尾の「セットコード 番号」は省略できる。セットコードは大文字で始まり、番号は数字を含む
+	entryPattern = regexp.MustCompile(`^(\d+)[xX]?\s+(.+?)(?:\s+([A-Z][A-Za-z0-9+-]*)\s+([A-Za-z]*\d+[A-Za-z]*))?$`)
+)
+
+// Parse テキストを1行ずつ読み取る。読み取れなかった行はエラーとして返し、残りの行は読み進める
+func Parse(text string) ([]Entry, []*ParseError) {
+	var entries []Entry
+	var errs []*ParseError
+	section := SectionUnknown
+
+	for i, raw := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		if m := headerPattern.FindStringSubmatch(line); m != nil {
+			title := strings.ToLower(strings.TrimSpace(m[1]))
+			if s, ok := sectionAliases[title]; ok {
+				section = s
+				continue
+			}
+			// 「Total Cards: 60」のような集計行は読み飛ばす
+			if !entryPattern.MatchString(line) {
+				continue
+			}
+		}
+
+		m := entryPattern.FindStringSubmatch(line)
+		if m == nil {
+			errs = append(errs, &ParseError{Line: i + 1, Raw: line})
+			continue
+		}
+		quantity, err := strconv.Atoi(m[1])
+		if err != nil || quantity < 1 {
+			errs = append(errs, &ParseError{Line: i + 1, Raw: line})
+			continue
+		}
+		entries = append(entries, Entry{
+			Section:  section,
+			Quantity: quantity,
+			Name:     strings.TrimSpace(m[2]),
+			SetCode:  m[3],
+			Number:   m[4],
+			Line:     i + 1,
+		})
+	}
+
+	return entries, errs
+}
+
+// Render 見出しごとにまとめて出力する。見出しのない行はエネルギーの後に出力する
+func Render(entries []Entry) string {
+	var b strings.Builder
+	total := 0
+
+	writeSection := func(title string, lines []Entry) {
+		if len(lines) == 0 {
+			return
+		}
+		count := 0
+		for _, e := range lines {
+			count += e.Quantity
+		}
+		total += count
+		if title != "" {
+			fmt.Fprintf(&b, "%s: %d\n", title, count)
+		}
+		for _, e := range lines {
+			b.WriteString(e.String())
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	for _, s := range sectionOrder {
+		writeSection(sectionTitles[s], filterSection(entries, s))
+	}
+	writeSection("", filterSection(entries, SectionUnknown))
+
+	fmt.Fprintf(&b, "Total Cards: %d\n", total)
+	return b.String()
+}
+
+// String 1行分を「枚数 カード名 セットコード 番号」の形式で出力する
+func (e Entry) String() string {
+	if e.SetCode == "" || e.Number == "" {
+		return fmt.Sprintf("%d %s", e.Quantity, e.Name)
+	}
+	return fmt.Sprintf("%d %s %s %s", e.Quantity, e.Name, e.SetCode, e.Number)
+}
+
+func filterSection(entries []Entry, section Section) []Entry {
+	var filtered []Entry
+	for _, e := range entries {
+		if e.Section == section {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,100 @@
+package decklist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	text := "Pokémon: 6\r\n" +
+		"4 Pikachu ex SVI 57\r\n" +
+		"2 Mew VMAX FST 114\r\n" +
+		"\r\n" +
+		"Trainer: 5\r\n" +
+		"4 Iono PAL 185\r\n" +
+		"1 Prime Catcher TEF 157\r\n" +
+		"\r\n" +
+		"Energy: 12\r\n" +
+		"12 Basic {L} Energy SVE 4\r\n" +
+		"Total Cards: 23\r\n"
+
+	entries, errs := Parse(text)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	want := []Entry{
+		{Section: SectionPokemon, Quantity: 4, Name: "Pikachu ex", SetCode: "SVI", Number: "57", Line: 2},
+		{Section: SectionPokemon, Quantity: 2, Name: "Mew VMAX", SetCode: "FST", Number: "114", Line: 3},
+		{Section: SectionTrainer, Quantity: 4, Name: "Iono", SetCode: "PAL", Number: "185", Line: 6},
+		{Section: SectionTrainer, Quantity: 1, Name: "Prime Catcher", SetCode: "TEF", Number: "157", Line: 7},
+		{Section: SectionEnergy, Quantity: 12, Name: "Basic {L} Energy", SetCode: "SVE", Number: "4", Line: 10},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("unexpected entries:\n got: %+v\nwant: %+v", entries, want)
+	}
+}
+
+func TestParse_Variants(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want Entry
+	}{
+		{"without set", "4 ナンジャモ", Entry{Quantity: 4, Name: "ナンジャモ", Line: 1}},
+		{"japanese set code", "2 サーナイトex SV1a 38", Entry{Quantity: 2, Name: "サーナイトex", SetCode: "SV1a", Number: "38", Line: 1}},
+		{"gallery number", "1 Pikachu VMAX CRZ GG30", Entry{Quantity: 1, Name: "Pikachu VMAX", SetCode: "CRZ", Number: "GG30", Line: 1}},
+		{"set code with plus", "4 ハイパーボール SM1+ 12", Entry{Quantity: 4, Name: "ハイパーボール", SetCode: "SM1+", Number: "12", Line: 1}},
+		{"x suffix", "3x Nest Ball", Entry{Quantity: 3, Name: "Nest Ball", Line: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, errs := Parse(tt.line)
+			if len(errs) != 0 || len(entries) != 1 {
+				t.Fatalf("expected one entry, got %+v %v", entries, errs)
+			}
+			if entries[0] != tt.want {
+				t.Errorf("got %+v, want %+v", entries[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	entries, errs := Parse("ポケモン: 4\n4 ピカチュウex\nピカチュウex\n0 ナンジャモ")
+	if len(entries) != 1 || entries[0].Section != SectionPokemon {
+		t.Errorf("expected the valid line to be parsed, got %+v", entries)
+	}
+	if len(errs) != 2 || errs[0].Line != 3 || errs[1].Line != 4 {
+		t.Errorf("expected errors on lines 3 and 4, got %v", errs)
+	}
+}
+
+func TestRender(t *testing.T) {
+	entries := []Entry{
+		{Section: SectionEnergy, Quantity: 10, Name: "基本雷エネルギー", SetCode: "SVE", Number: "4"},
+		{Section: SectionPokemon, Quantity: 4, Name: "ピカチュウex", SetCode: "SV1a", Number: "29"},
+		{Section: SectionTrainer, Quantity: 4, Name: "ナンジャモ"},
+	}
+
+	want := "Pokémon: 4\n" +
+		"4 ピカチュウex SV1a 29\n" +
+		"\n" +
+		"Trainer: 4\n" +
+		"4 ナンジャモ\n" +
+		"\n" +
+		"Energy: 10\n" +
+		"10 基本雷エネルギー SVE 4\n" +
+		"\n" +
+		"Total Cards: 18\n"
+	got := Render(entries)
+	if got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+
+	// 出力したものを読み直すと同じ内容になる
+	parsed, errs := Parse(got)
+	if len(errs) != 0 || len(parsed) != len(entries) {
+		t.Fatalf("expected round trip, got %+v %v", parsed, errs)
+	}
+}
@@ -0,0 +1,51 @@
+package lru
+
+import "testing"
+
+func TestCache_Evict(t *testing.T) {
+	c := New[string, int](2)
+	c.Add("a", 1)
+	c.Add("b", 2)
+
+	// aを使ったので、次に追加したときに捨てられるのはb
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %d, %v", v, ok)
+	}
+	c.Add("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for key, want := range map[string]int{"a": 1, "c": 3} {
+		if v, ok := c.Get(key); !ok || v != want {
+			t.Errorf("expected %s=%d, got %d, %v", key, want, v, ok)
+		}
+	}
+
+	stats := c.Stats()
+	want := Stats{Size: 2, Capacity: 2, Hits: 3, Misses: 1, Evictions: 1}
+	if stats != want {
+		t.Errorf("expected %+v, got %+v", want, stats)
+	}
+	if stats.HitRate() != 0.75 {
+		t.Errorf("expected hit rate 0.75, got %v", stats.HitRate())
+	}
+}
+
+func TestCache_Purge(t *testing.T) {
+	c := New[int, string](10)
+	c.Add(1, "a")
+	c.Add(1, "b")
+	if v, _ := c.Get(1); v != "b" {
+		t.Fatalf("expected the value to be replaced, got %s", v)
+	}
+
+	c.Purge()
+
+	if _, ok := c.Get(1); ok {
+		t.Error("expected the cache to be empty after purge")
+	}
+	if stats := c.Stats(); stats.Size != 0 || stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("unexpected stats after purge: %+v", stats)
+	}
+}
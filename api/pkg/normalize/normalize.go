@@ -0,0 +1,260 @@
+// Package normalize は検索語とインデックスに登録する文字列を同じ形にそろえる
+//
+// 入力の揺れ(全角英数字・半角カナ・ローマ字・ひらがな・小書きのかな・長音の有無)を吸収するため、
+// 比べる両方に同じ処理をかける。戻り値は表示には使わない
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// String NFKC → 小文字 → ローマ字をカタカナに → ひらがなをカタカナに → 小書きのかなを大きく → 長音を落とす
+func String(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+	s = expandMacrons(s)
+	s = romajiToKatakana(s)
+	s = foldKana(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Latin String と同じだが、英字をローマ字として読まずにそのまま残す
+// 英語のカード名と英語で入力した検索語に使う。入力途中の ultra ba の ba だけがカナになると ultra ball に前方一致しなくなる
+func Latin(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+	s = foldKana(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// foldKana ひらがなをカタカナにし、小書きのかなと長音の違いを吸収する
+// 「チュウ」「チュー」「チュ」は同じ「チユ」になる
+func foldKana(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	var prev rune
+	for _, r := range s {
+		if r >= 'ぁ' && r <= 'ゖ' {
+			r = r - 'ぁ' + 'ァ'
+		}
+		if large, ok := smallKana[r]; ok {
+			r = large
+		}
+		// ローマ字で書いた長音(sa-naito)はハイフンになる
+		if r == 'ー' || (r == '-' && prev != 0) {
+			continue
+		}
+		// 直前の音と同じ母音(オウのウも含む)は伸ばしているだけとみなす
+		if v := vowelOf(r); v != 0 && isVowelKana(r) && prev != 0 && (v == prev || (r == 'ウ' && prev == 'o')) {
+			continue
+		}
+		prev = vowelOf(r)
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var smallKana = map[rune]rune{
+	'ァ': 'ア', 'ィ': 'イ', 'ゥ': 'ウ', 'ェ': 'エ', 'ォ': 'オ',
+	'ッ': 'ツ', 'ャ': 'ヤ', 'ュ': 'ユ', 'ョ': 'ヨ', 'ヮ': 'ワ',
+	'ヵ': 'カ', 'ヶ': 'ケ',
+	// 発音が同じでローマ字では書き分けない
+	'ヂ': 'ジ', 'ヅ': 'ズ', 'ヰ': 'イ', 'ヱ': 'エ',
+}
+
+var vowelRows = map[rune]string{
+	'a': "アカガサザタダナハバパマヤラワ",
+	'i': "イキギシジチニヒビピミリ",
+	'u': "ウクグスズツヌフブプムユルヴ",
+	'e': "エケゲセゼテデネヘベペメレ",
+	'o': "オコゴソゾトドノホボポモヨロヲ",
+}
+
+var kanaVowels = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for v, row := range vowelRows {
+		for _, r := range row {
+			m[r] = v
+		}
+	}
+	return m
+}()
+
+func vowelOf(r rune) rune {
+	return kanaVowels[r]
+}
+
+func isVowelKana(r rune) bool {
+	return strings.ContainsRune("アイウエオ", r)
+}
+
+// expandMacrons ヘボン式の長音記号(ō)を母音と長音に分ける
+func expandMacrons(s string) string {
+	if !strings.ContainsAny(s, "āīūēōâîûêô") {
+		return s
+	}
+	return macronReplacer.Replace(s)
+}
+
+var macronReplacer = strings.NewReplacer(
+	"ā", "aー", "ī", "iー", "ū", "uー", "ē", "eー", "ō", "oー",
+	"â", "aー", "î", "iー", "û", "uー", "ê", "eー", "ô", "oー",
+)
+
+// romajiToKatakana 英字の並びのうち、すべてローマ字として読めるものだけをカタカナにする
+// exやVSTARのようにカード名に英字のまま含まれる語は変換しない
+func romajiToKatakana(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		if !isLatin(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isLatin(runes[j]) {
+			j++
+		}
+		b.WriteString(convertWord(string(runes[i:j])))
+		i = j
+	}
+	return b.String()
+}
+
+func isLatin(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+// カード名に英字のまま入る語。長いものから順に後ろへの一致を試す
+var latinWords = []string{"vunion", "vstar", "break", "prism", "vmax", "lvx", "ex", "gx", "v"}
+
+func convertWord(word string) string {
+	for _, w := range latinWords {
+		if word == w {
+			return word
+		}
+	}
+	if kana, ok := convertRomaji(word); ok {
+		return kana
+	}
+	// pikachuex のように英字の語が後ろに続いている
+	for _, w := range latinWords {
+		if stem, found := strings.CutSuffix(word, w); found && stem != "" {
+			if kana, ok := convertRomaji(stem); ok {
+				return kana + w
+			}
+		}
+	}
+	return word
+}
+
+func convertRomaji(word string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(word); {
+		c := word[i]
+		next := byte(0)
+		if i+1 < len(word) {
+			next = word[i+1]
+		}
+
+		switch {
+		case c == 'n' && next == '\'':
+			b.WriteString("ン")
+			i += 2
+			continue
+		case c == 'n' && !isRomajiVowel(next) && next != 'y':
+			// nn のあとに母音が続くとき(konna)は、2つめのnを次の音に使う
+			b.WriteString("ン")
+			if next == 'n' && (i+2 >= len(word) || !isRomajiVowel(word[i+2]) && word[i+2] != 'y') {
+				i += 2
+			} else {
+				i++
+			}
+			continue
+		case c == 'm' && (next == 'b' || next == 'm' || next == 'p'):
+			b.WriteString("ン")
+			i++
+			continue
+		case c == next && !isRomajiVowel(c):
+			b.WriteString("ッ")
+			i++
+			continue
+		case c == 't' && strings.HasPrefix(word[i:], "tch"):
+			b.WriteString("ッ")
+			i++
+			continue
+		}
+
+		matched := false
+		for l := 3; l >= 1; l-- {
+			if i+l > len(word) {
+				continue
+			}
+			if kana, ok := romajiTable[word[i:i+l]]; ok {
+				b.WriteString(kana)
+				i += l
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+func isRomajiVowel(c byte) bool {
+	return c == 'a' || c == 'i' || c == 'u' || c == 'e' || c == 'o'
+}
+
+// romajiTable ヘボン式と訓令式の両方の綴りを受け付ける。lはrと同じに扱う
+var romajiTable = func() map[string]string {
+	m := map[string]string{
+		"a": "ア", "i": "イ", "u": "ウ", "e": "エ", "o": "オ",
+		"ka": "カ", "ki": "キ", "ku": "ク", "ke": "ケ", "ko": "コ",
+		"kya": "キャ", "kyu": "キュ", "kyo": "キョ",
+		"ga": "ガ", "gi": "ギ", "gu": "グ", "ge": "ゲ", "go": "ゴ",
+		"gya": "ギャ", "gyu": "ギュ", "gyo": "ギョ",
+		"sa": "サ", "si": "シ", "shi": "シ", "su": "ス", "se": "セ", "so": "ソ",
+		"sha": "シャ", "shu": "シュ", "sho": "ショ", "she": "シェ",
+		"sya": "シャ", "syu": "シュ", "syo": "ショ",
+		"za": "ザ", "zi": "ジ", "ji": "ジ", "zu": "ズ", "ze": "ゼ", "zo": "ゾ",
+		"ja": "ジャ", "ju": "ジュ", "jo": "ジョ", "je": "ジェ",
+		"jya": "ジャ", "jyu": "ジュ", "jyo": "ジョ",
+		"zya": "ジャ", "zyu": "ジュ", "zyo": "ジョ",
+		"ta": "タ", "ti": "チ", "chi": "チ", "tu": "ツ", "tsu": "ツ", "te": "テ", "to": "ト",
+		"cha": "チャ", "chu": "チュ", "cho": "チョ", "che": "チェ",
+		"tya": "チャ", "tyu": "チュ", "tyo": "チョ",
+		"cya": "チャ", "cyu": "チュ", "cyo": "チョ",
+		"thi": "ティ",
+		"da":  "ダ", "di": "ディ", "du": "ドゥ", "de": "デ", "do": "ド",
+		"na": "ナ", "ni": "ニ", "nu": "ヌ", "ne": "ネ", "no": "ノ",
+		"nya": "ニャ", "nyu": "ニュ", "nyo": "ニョ",
+		"ha": "ハ", "hi": "ヒ", "hu": "フ", "fu": "フ", "he": "ヘ", "ho": "ホ",
+		"hya": "ヒャ", "hyu": "ヒュ", "hyo": "ヒョ",
+		"fa": "ファ", "fi": "フィ", "fe": "フェ", "fo": "フォ",
+		"ba": "バ", "bi": "ビ", "bu": "ブ", "be": "ベ", "bo": "ボ",
+		"bya": "ビャ", "byu": "ビュ", "byo": "ビョ",
+		"pa": "パ", "pi": "ピ", "pu": "プ", "pe": "ペ", "po": "ポ",
+		"pya": "ピャ", "pyu": "ピュ", "pyo": "ピョ",
+		"ma": "マ", "mi": "ミ", "mu": "ム", "me": "メ", "mo": "モ",
+		"mya": "ミャ", "myu": "ミュ", "myo": "ミョ",
+		"ya": "ヤ", "yu": "ユ", "ye": "イェ", "yo": "ヨ",
+		"ra": "ラ", "ri": "リ", "ru": "ル", "re": "レ", "ro": "ロ",
+		"rya": "リャ", "ryu": "リュ", "ryo": "リョ",
+		"wa": "ワ", "wi": "ウィ", "we": "ウェ", "wo": "ヲ",
+		"va": "ヴァ", "vi": "ヴィ", "vu": "ヴ", "ve": "ヴェ", "vo": "ヴォ",
+	}
+	for k, v := range m {
+		if strings.HasPrefix(k, "r") {
+			m["l"+k[1:]] = v
+		}
+	}
+	return m
+}()
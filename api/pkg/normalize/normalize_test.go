@@ -0,0 +1,150 @@
+package normalize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"ピカチュウ", "ピカチユ"},
+		{"リザードンex", "リザドンex"},
+		{"基本水エネルギー", "基本水エネルギ"},
+		{"ナンジャモ", "ナンジヤモ"},
+		{"ボスの指令", "ボスノ指令"},
+		{"  ピカチュウ　ex  ", "ピカチユ ex"},
+		{"ドラパルトＥＸ", "ドラパルトex"},
+		{"ｴﾈﾙｷﾞｰ", "エネルギ"},
+		{"ﾋﾟｶﾁｭｳ", "ピカチユ"},
+		{"こうげき", "コゲキ"},
+		{"pikachu", "ピカチユ"},
+		{"shinjitsu", "シンジツ"},
+		{"konna", "コンナ"},
+		{"kinnoi", "キンノイ"},
+		{"gekkouga", "ゲツコガ"},
+		{"sanda-", "サンダ"},
+		{"GX-", "gx-"},
+		{"Rizādon", "リザドン"},
+		{"vstar", "vstar"},
+		{"rocket", "rocket"},
+		{"ace spec", "ace spec"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, String(tt.in))
+		})
+	}
+}
+
+// 検索語とカード名を同じように正規化すれば一致する
+func TestString_QueryMatchesName(t *testing.T) {
+	tests := []struct {
+		query string
+		name  string
+	}{
+		// ローマ字
+		{"pikachu", "ピカチュウ"},
+		{"PikachuEX", "ピカチュウex"},
+		{"pikachuex", "ピカチュウex"},
+		{"rizaadon", "リザードン"},
+		{"rizadon", "リザードン"},
+		{"gekkouga", "ゲッコウガ"},
+		{"nanjamo", "ナンジャモ"},
+		{"nanjyamo", "ナンジャモ"},
+		{"dorapaluto", "ドラパルト"},
+		{"kirulia", "キルリア"},
+		{"sa-naito", "サーナイト"},
+		{"sanaito", "サーナイト"},
+		{"myuutsu", "ミュウツー"},
+		{"fushigidane", "フシギダネ"},
+		{"hushigidane", "フシギダネ"},
+		{"ti-ja", "チージャ"},
+		// 全角英数字・半角カナ
+		{"ｐｉｋａｃｈｕ", "ピカチュウ"},
+		{"ﾘｻﾞｰﾄﾞﾝex", "リザードンex"},
+		{"リザードンＥＸ", "リザードンex"},
+		{"ボスの指令（サカキ）", "ボスの指令(サカキ)"},
+		// ひらがな・長音と小書きのかなの有無
+		{"りざーどん", "リザードン"},
+		{"リザドン", "リザードン"},
+		{"ピカチユウ", "ピカチュウ"},
+		{"ピカチュー", "ピカチュウ"},
+		{"げっこうが", "ゲッコウガ"},
+		{"ゲツコウガ", "ゲッコウガ"},
+		{"みゅうつー", "ミュウツー"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, String(tt.name), String(tt.query))
+		})
+	}
+}
+
+// 別のカードまで同じ形にならない
+func TestString_KeepsDifferentNames(t *testing.T) {
+	tests := []struct {
+		a string
+		b string
+	}{
+		{"リザードン", "リザード"},
+		{"ピカチュウ", "ライチュウ"},
+		{"カイリュー", "カイリキー"},
+		{"ピカチュウex", "ピカチュウV"},
+		{"サーナイト", "エルレイド"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			assert.NotEqual(t, String(tt.a), String(tt.b))
+		})
+	}
+}
+
+func TestLatin(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Ultra Ball", "ultra ball"},
+		{"ultra ba", "ultra ba"},
+		{"Rare Candy", "rare candy"},
+		{"chari", "chari"},
+		{"Charizard ex", "charizard ex"},
+		{"  Ｐｉｋａｃｈｕ　ex ", "pikachu ex"},
+		{"Boss's Orders", "boss's orders"},
+		{"リザードン", "リザドン"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, Latin(tt.in))
+		})
+	}
+}
+
+// 英語名は入力の途中でもカード名の先頭と一致する
+func TestLatin_PartialQueryPrefixesName(t *testing.T) {
+	tests := []struct {
+		query string
+		name  string
+	}{
+		{"ultra ba", "Ultra Ball"},
+		{"ultra", "Ultra Ball"},
+		{"rare", "Rare Candy"},
+		{"rare ca", "Rare Candy"},
+		{"chari", "Charizard ex"},
+		{"charizard e", "Charizard ex"},
+		{"pika", "Pikachu ex"},
+		{"bo", "Boss's Orders"},
+		{"IONO", "Iono"},
+		{"ｎｅｓｔ ｂａ", "Nest Ball"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query+"/"+tt.name, func(t *testing.T) {
+			assert.True(t, strings.HasPrefix(Latin(tt.name), Latin(tt.query)), "%q should prefix %q", Latin(tt.query), Latin(tt.name))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Package ratelimit はキーごとのトークンバケットでリクエスト数を制限する
+//
+// バケットはburst個のトークンを持ち、1秒あたりrate個ずつ補充される。
+// 1リクエストごとに1トークンを使い、トークンがなければ次の1個が補充されるまでの時間を返す
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// しばらく使われていないバケットを消す間隔
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	// テストで時刻を差し替えるため
+	now func() time.Time
+}
+
+// NewLimiter ratePerSecondは1秒あたりに補充するトークン数、burstはまとめて使えるトークンの上限
+func NewLimiter(ratePerSecond float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:    ratePerSecond,
+		burst:   float64(burst),
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Allow トークンを1つ使う。使えなかった場合は次のトークンが補充されるまでの時間を返す
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	if l.rate <= 0 {
+		return false, time.Duration(math.MaxInt64)
+	}
+	wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// 満タンに戻っているバケットは新しく作っても同じなので消す
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(ratePerSecond float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewLimiter(ratePerSecond, burst)
+	l.now = clock.Now
+	return l, clock
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, clock := newTestLimiter(2, 3)
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("key"); !ok {
+			t.Fatalf("request %d should be allowed within burst", i+1)
+		}
+	}
+
+	ok, wait := l.Allow("key")
+	if ok {
+		t.Fatal("expected the 4th request to be limited")
+	}
+	if wait != 500*time.Millisecond {
+		t.Errorf("expected to wait 500ms, got %v", wait)
+	}
+
+	// 他のキーには影響しない
+	if ok, _ := l.Allow("other"); !ok {
+		t.Error("expected other key to be allowed")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if ok, _ := l.Allow("key"); !ok {
+		t.Error("expected a token to be refilled after 500ms")
+	}
+	if ok, _ := l.Allow("key"); ok {
+		t.Error("expected only one token to be refilled")
+	}
+
+	// 補充はburstを超えない
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		l.Allow("key")
+	}
+	if ok, _ := l.Allow("key"); ok {
+		t.Error("expected refill to be capped at burst")
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	l, clock := newTestLimiter(1, 1)
+	l.Allow("idle")
+
+	clock.Advance(2 * sweepInterval)
+	l.Allow("active")
+
+	if _, ok := l.buckets["idle"]; ok {
+		t.Error("expected idle bucket to be removed")
+	}
+	if _, ok := l.buckets["active"]; !ok {
+		t.Error("expected active bucket to be kept")
+	}
+}
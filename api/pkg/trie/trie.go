@@ -0,0 +1,110 @@
+// Package trie は文字列の前方一致で値を探す
+//
+// 子は文字コード順に並べて持つので、同じ条件なら結果の順番は毎回同じになる。
+// 並行して読み取れるが、書き込みとは排他しない。作り直すときは新しいTrieを作って差し替える
+package trie
+
+import "sort"
+
+type node[V any] struct {
+	// 文字コード順
+	labels   []rune
+	children []*node[V]
+	value    V
+	ok       bool
+}
+
+func (n *node[V]) child(r rune) *node[V] {
+	i := sort.Search(len(n.labels), func(i int) bool { return n.labels[i] >= r })
+	if i < len(n.labels) && n.labels[i] == r {
+		return n.children[i]
+	}
+	return nil
+}
+
+func (n *node[V]) addChild(r rune) *node[V] {
+	i := sort.Search(len(n.labels), func(i int) bool { return n.labels[i] >= r })
+	if i < len(n.labels) && n.labels[i] == r {
+		return n.children[i]
+	}
+	c := &node[V]{}
+	n.labels = append(n.labels, 0)
+	copy(n.labels[i+1:], n.labels[i:])
+	n.labels[i] = r
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = c
+	return c
+}
+
+type Trie[V any] struct {
+	root *node[V]
+	size int
+}
+
+func New[V any]() *Trie[V] {
+	return &Trie[V]{root: &node[V]{}}
+}
+
+// Put 同じキーがあれば値を置き換える
+func (t *Trie[V]) Put(key string, value V) {
+	n := t.root
+	for _, r := range key {
+		n = n.addChild(r)
+	}
+	if !n.ok {
+		t.size++
+	}
+	n.value = value
+	n.ok = true
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.ok {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Len キーの数
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// WithPrefix prefixで始まるキーの値を、短いキーから順にlimit件まで返す。同じ長さのキーは文字コード順
+// limit件見つかった時点で探すのをやめるので、短いprefixでも木全体はたどらない
+func (t *Trie[V]) WithPrefix(prefix string, limit int) []V {
+	start := t.find(prefix)
+	if start == nil || limit <= 0 {
+		return nil
+	}
+
+	var values []V
+	level := []*node[V]{start}
+	for len(level) > 0 {
+		var next []*node[V]
+		for _, n := range level {
+			if n.ok {
+				values = append(values, n.value)
+				if len(values) == limit {
+					return values
+				}
+			}
+			next = append(next, n.children...)
+		}
+		level = next
+	}
+	return values
+}
+
+func (t *Trie[V]) find(key string) *node[V] {
+	n := t.root
+	for _, r := range key {
+		if n = n.child(r); n == nil {
+			return nil
+		}
+	}
+	return n
+}
@@ -0,0 +1,47 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrie_WithPrefix(t *testing.T) {
+	tr := New[string]()
+	for _, name := range []string{"リザードンex", "リザード", "リーリエ", "リザードン", "ヒトカゲ"} {
+		tr.Put(name, name)
+	}
+
+	// 短い名前から順に返す
+	assert.Equal(t, []string{"リザード", "リザードン", "リザードンex"}, tr.WithPrefix("リザ", 10))
+	assert.Equal(t, []string{"リザード", "リーリエ"}, tr.WithPrefix("リ", 2))
+	assert.Equal(t, []string{"リザードンex"}, tr.WithPrefix("リザードンe", 10))
+	assert.Empty(t, tr.WithPrefix("ピ", 10))
+	assert.Empty(t, tr.WithPrefix("リ", 0))
+	assert.Len(t, tr.WithPrefix("", 10), 5)
+}
+
+func TestTrie_PutReplaces(t *testing.T) {
+	tr := New[int]()
+	tr.Put("ナンジャモ", 1)
+	tr.Put("ナンジャモ", 2)
+	tr.Put("ナ", 3)
+
+	v, ok := tr.Get("ナンジャモ")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, tr.Len())
+
+	// 途中までのキーは登録していなければ見つからない
+	_, ok = tr.Get("ナンジャ")
+	assert.False(t, ok)
+}
+
+func TestTrie_SameLengthInCodeOrder(t *testing.T) {
+	tr := New[string]()
+	for _, name := range []string{"アc", "アa", "アb"} {
+		tr.Put(name, name)
+	}
+
+	assert.Equal(t, []string{"アa", "アb", "アc"}, tr.WithPrefix("ア", 10))
+}
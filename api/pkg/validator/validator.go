@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"strconv"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -11,6 +13,16 @@ var (
 func GetValidator() *validator.Validate {
 	if validate == nil {
 		validate = validator.New()
+		// maxはルーン数で数えるため、バイト数で制限したい項目にはmax_bytesを使う
+		validate.RegisterValidation("max_bytes", maxBytes)
 	}
 	return validate
 }
+
+func maxBytes(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		return false
+	}
+	return len(fl.Field().String()) <= limit
+}
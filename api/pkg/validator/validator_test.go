@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxBytes(t *testing.T) {
+	type request struct {
+		Password string `validate:"max_bytes=72"`
+	}
+
+	tests := map[string]struct {
+		password    string
+		expectError bool
+	}{
+		"ascii at the limit": {password: strings.Repeat("a", 72)},
+		"ascii over the limit": {
+			password:    strings.Repeat("a", 73),
+			expectError: true,
+		},
+		// 30文字でも1文字3バイトなので90バイトになる
+		"multibyte over the limit": {
+			password:    strings.Repeat("ピ", 30),
+			expectError: true,
+		},
+		"multibyte within the limit": {password: strings.Repeat("ピ", 24)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := GetValidator().Struct(request{Password: tt.password})
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error %v, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	cacheUseCase "api/application/cache"
+	"api/application/search/suggest"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 運用向けAPIのハンドラー
+type adminHandler struct {
+	cardCacheUseCase cacheUseCase.ICardCacheUseCase
+	suggestUseCase   *suggest.SuggestUseCase
+}
+
+func NewAdminHandler(cardCacheUseCase cacheUseCase.ICardCacheUseCase, suggestUseCase *suggest.SuggestUseCase) *adminHandler {
+	return &adminHandler{
+		cardCacheUseCase: cardCacheUseCase,
+		suggestUseCase:   suggestUseCase,
+	}
+}
+
+// GetCardCacheStats godoc
+// @Summary Get card cache size and hit/miss counters
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} cardCacheResponse
+// @Failure 403 {object} errorResponse
+// @Router /v1/admin/cache/cards [get]
+func (h *adminHandler) GetCardCacheStats(c echo.Context) error {
+	stats := h.cardCacheUseCase.GetStats(c.Request().Context())
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"cache":  stats,
+	})
+}
+
+// InvalidateCardCache godoc
+// @Summary Drop all cached cards after card data is re-imported
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} cardCacheResponse
+// @Failure 403 {object} errorResponse
+// @Router /v1/admin/cache/cards [delete]
+func (h *adminHandler) InvalidateCardCache(c echo.Context) error {
+	stats := h.cardCacheUseCase.Invalidate(c.Request().Context())
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"cache":  stats,
+	})
+}
+
+// RefreshSuggestIndex godoc
+// @Summary Rebuild the card name suggest index after card data is re-imported
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} suggestIndexResponse
+// @Failure 403 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Router /v1/admin/suggest/refresh [post]
+func (h *adminHandler) RefreshSuggestIndex(c echo.Context) error {
+	dto, err := h.suggestUseCase.Refresh(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse{Result: false, Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, suggestIndexResponse{
+		Result: true,
+		Index:  dto,
+	})
+}
@@ -0,0 +1,24 @@
+package admin
+
+import (
+	cacheUseCase "api/application/cache"
+	"api/application/search/suggest"
+)
+
+// GetCardCacheStats・InvalidateCardCache Response
+type cardCacheResponse struct {
+	Result bool                            `json:"result"`
+	Cache  *cacheUseCase.CardCacheStatsDto `json:"cache"`
+}
+
+// RefreshSuggestIndex Response
+type suggestIndexResponse struct {
+	Result bool                `json:"result"`
+	Index  *suggest.RefreshDto `json:"index"`
+}
+
+// 認証エラーの Response
+type errorResponse struct {
+	Result bool   `json:"result"`
+	Error  string `json:"error"`
+}
@@ -0,0 +1,160 @@
+package apikey
+
+import (
+	apiKeyUseCase "api/application/apikey"
+	"api/domain/apikey"
+	domainErr "api/domain/error"
+	"api/pkg/validator"
+	authMiddleware "api/presentation/middleware"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type apiKeyHandler struct {
+	apiKeyUseCase apiKeyUseCase.IApiKeyUseCase
+}
+
+func NewApiKeyHandler(apiKeyUseCase apiKeyUseCase.IApiKeyUseCase) *apiKeyHandler {
+	return &apiKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// IssueApiKey godoc
+// @Summary Issue a personal API key
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body issueApiKeyRequest true "Key name and scopes"
+// @Success 200 {object} issueApiKeyResponse
+// @Router /v1/api-keys [post]
+func (h *apiKeyHandler) IssueApiKey(c echo.Context) error {
+	userId, err := getSessionUserId(c)
+	if err != nil {
+		return sessionRequiredResponse(c)
+	}
+
+	var req issueApiKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	issued, err := h.apiKeyUseCase.Issue(c.Request().Context(), &apiKeyUseCase.IssueApiKeyRequestDto{
+		UserID: userId,
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	})
+	if err != nil {
+		if errors.Is(err, apikey.ErrUnknownScope) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":  true,
+		"api_key": issued,
+	})
+}
+
+// GetApiKeys godoc
+// @Summary List personal API keys
+// @Tags api-key
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} getApiKeysResponse
+// @Router /v1/api-keys [get]
+func (h *apiKeyHandler) GetApiKeys(c echo.Context) error {
+	userId, err := getSessionUserId(c)
+	if err != nil {
+		return sessionRequiredResponse(c)
+	}
+
+	keys, err := h.apiKeyUseCase.List(c.Request().Context(), userId)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":   true,
+		"api_keys": keys,
+	})
+}
+
+// RevokeApiKey godoc
+// @Summary Revoke a personal API key
+// @Tags api-key
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "API key ID"
+// @Success 200 {object} revokeApiKeyResponse
+// @Router /v1/api-keys/{id} [delete]
+func (h *apiKeyHandler) RevokeApiKey(c echo.Context) error {
+	userId, err := getSessionUserId(c)
+	if err != nil {
+		return sessionRequiredResponse(c)
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なAPIキーIDです",
+		})
+	}
+
+	if err := h.apiKeyUseCase.Revoke(c.Request().Context(), id, userId); err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"result": false,
+				"error":  "APIキーが見つかりません",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":  true,
+		"message": "APIキーを無効化しました",
+	})
+}
+
+// 漏れたキーで新しいキーを発行されないよう、キーの管理はログインしたユーザーだけができる
+func getSessionUserId(c echo.Context) (string, error) {
+	if authMiddleware.IsApiKeyRequest(c) {
+		return "", authMiddleware.ErrUnauthorized
+	}
+	return authMiddleware.GetUserId(c)
+}
+
+func sessionRequiredResponse(c echo.Context) error {
+	return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+		"result": false,
+		"error":  "APIキーの管理にはログインが必要です",
+	})
+}
@@ -0,0 +1,8 @@
+package apikey
+
+// IssueApiKey Request
+type issueApiKeyRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+	// search:read, deck:read, deck:write
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+}
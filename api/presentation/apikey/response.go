@@ -0,0 +1,21 @@
+package apikey
+
+import apiKeyUseCase "api/application/apikey"
+
+// IssueApiKey Response
+type issueApiKeyResponse struct {
+	Result bool                           `json:"result"`
+	ApiKey *apiKeyUseCase.IssuedApiKeyDto `json:"api_key"`
+}
+
+// GetApiKeys Response
+type getApiKeysResponse struct {
+	Result  bool                      `json:"result"`
+	ApiKeys []apiKeyUseCase.ApiKeyDto `json:"api_keys"`
+}
+
+// RevokeApiKey Response
+type revokeApiKeyResponse struct {
+	Result  bool   `json:"result"`
+	Message string `json:"message"`
+}
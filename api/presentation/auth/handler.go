@@ -0,0 +1,119 @@
+package auth
+
+import (
+	authUseCase "api/application/auth"
+	"api/domain/user"
+	"api/pkg/validator"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type authHandler struct {
+	registerUseCase authUseCase.IRegisterUseCase
+	loginUseCase    authUseCase.ILoginUseCase
+}
+
+func NewAuthHandler(
+	registerUseCase authUseCase.IRegisterUseCase,
+	loginUseCase authUseCase.ILoginUseCase,
+) *authHandler {
+	return &authHandler{
+		registerUseCase: registerUseCase,
+		loginUseCase:    loginUseCase,
+	}
+}
+
+// Register godoc
+// @Summary Register a user account
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body registerRequest true "Account information"
+// @Success 200 {object} authResponse
+// @Router /v1/auth/register [post]
+func (h *authHandler) Register(c echo.Context) error {
+	var req registerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	result, err := h.registerUseCase.Execute(c.Request().Context(), &authUseCase.RegisterRequestDto{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+	})
+	if err != nil {
+		if errors.Is(err, user.ErrEmailAlreadyExists) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"result": false,
+				"error":  "このメールアドレスは既に登録されています",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"auth":   result,
+	})
+}
+
+// Login godoc
+// @Summary Log in and get a session token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body loginRequest true "Credentials"
+// @Success 200 {object} authResponse
+// @Router /v1/auth/login [post]
+func (h *authHandler) Login(c echo.Context) error {
+	var req loginRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	result, err := h.loginUseCase.Execute(c.Request().Context(), &authUseCase.LoginRequestDto{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		if errors.Is(err, authUseCase.ErrInvalidCredentials) {
+			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"result": false,
+				"error":  "メールアドレスまたはパスワードが違います",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"auth":   result,
+	})
+}
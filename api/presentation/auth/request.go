@@ -0,0 +1,15 @@
+package auth
+
+// Register Request
+type registerRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Name  string `json:"name" validate:"required,max=255"`
+	// bcryptは72バイトまでしか使わないため、それより長いパスワードは受け付けない
+	Password string `json:"password" validate:"required,min=8,max_bytes=72"`
+}
+
+// Login Request
+type loginRequest struct {
+	Email    string `json:"email" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
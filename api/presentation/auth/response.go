@@ -0,0 +1,9 @@
+package auth
+
+import authUseCase "api/application/auth"
+
+// Register・Login Response
+type authResponse struct {
+	Result bool                 `json:"result"`
+	Auth   *authUseCase.AuthDto `json:"auth"`
+}
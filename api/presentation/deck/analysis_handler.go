@@ -0,0 +1,185 @@
+package deck
+
+import (
+	deckUseCase "api/application/deck"
+	domainDeck "api/domain/deck"
+	domainErr "api/domain/error"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// デッキの中身を変更せずに分析するAPIのハンドラー
+type deckAnalysisHandler struct {
+	deckProbabilityUseCase deckUseCase.IDeckProbabilityUseCase
+	simulateDeckUseCase    deckUseCase.ISimulateDeckUseCase
+}
+
+func NewDeckAnalysisHandler(
+	deckProbabilityUseCase deckUseCase.IDeckProbabilityUseCase,
+	simulateDeckUseCase deckUseCase.ISimulateDeckUseCase,
+) *deckAnalysisHandler {
+	return &deckAnalysisHandler{
+		deckProbabilityUseCase: deckProbabilityUseCase,
+		simulateDeckUseCase:    simulateDeckUseCase,
+	}
+}
+
+// GetProbabilities godoc
+// @Summary Get opening hand and prize probabilities of a deck
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param turn query int false "Turn to calculate draw odds for (default 1)"
+// @Param at_least query int false "Minimum copies to draw by the turn (default 1)"
+// @Param group query []string false "Custom card group such as ドローサポート:博士の研究,ナンジャモ" collectionFormat(multi)
+// @Success 200 {object} deckProbabilityResponse
+// @Router /v1/decks/{id}/probabilities [get]
+func (h *deckAnalysisHandler) GetProbabilities(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	var req deckProbabilityRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+
+	requestDto := &deckUseCase.DeckProbabilityRequestDto{
+		Turn:    1,
+		AtLeast: 1,
+	}
+	if req.Turn != nil {
+		requestDto.Turn = *req.Turn
+	}
+	if req.AtLeast != nil {
+		requestDto.AtLeast = *req.AtLeast
+	}
+	// グループは「グループ名:カード名,カード名」の形式で複数指定できる
+	for _, g := range req.Groups {
+		name, cardNames, _ := strings.Cut(g, ":")
+		requestDto.Groups = append(requestDto.Groups, deckUseCase.CardGroupDto{
+			Name:      strings.TrimSpace(name),
+			CardNames: splitNonEmpty(cardNames, ","),
+		})
+	}
+
+	result, err := h.deckProbabilityUseCase.Execute(c.Request().Context(), deckId, requestDto)
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"result": false,
+				"error":  "デッキが見つかりません",
+			})
+		}
+		if errors.Is(err, deckUseCase.ErrInvalidProbabilityParams) || errors.Is(err, domainDeck.ErrInvalidCardGroup) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":        true,
+		"probabilities": result,
+	})
+}
+
+// Simulate godoc
+// @Summary Simulate the first turns of a deck without an opponent
+// @Tags deck
+// @Accept json
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param request body simulateDeckRequest true "Simulation settings"
+// @Success 200 {object} simulateDeckResponse
+// @Router /v1/decks/{id}/simulate [post]
+func (h *deckAnalysisHandler) Simulate(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	req := simulateDeckRequest{
+		Iterations: 10000,
+		Turns:      3,
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+
+	requestDto := &deckUseCase.SimulateDeckRequestDto{
+		Iterations: req.Iterations,
+		Turns:      req.Turns,
+		Seed:       req.Seed,
+	}
+	for _, s := range req.Searches {
+		requestDto.Searches = append(requestDto.Searches, deckUseCase.SearchEffectDto{
+			CardName: s.CardName,
+			Targets:  s.Targets,
+			Count:    s.Count,
+		})
+	}
+	for _, t := range req.Targets {
+		requestDto.Targets = append(requestDto.Targets, deckUseCase.CardGroupDto{
+			Name:      t.Name,
+			CardNames: t.CardNames,
+		})
+	}
+
+	result, err := h.simulateDeckUseCase.Execute(c.Request().Context(), deckId, requestDto)
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"result": false,
+				"error":  "デッキが見つかりません",
+			})
+		}
+		if errors.Is(err, domainDeck.ErrInvalidSimulationConfig) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":     true,
+		"simulation": result,
+	})
+}
+
+func splitNonEmpty(s string, sep string) []string {
+	var parts []string
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,129 @@
+package deck
+
+import (
+	deckUseCase "api/application/deck"
+	domainErr "api/domain/error"
+	"api/pkg/deckcode"
+	"api/pkg/validator"
+	authMiddleware "api/presentation/middleware"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// デッキIDを公開せずにデッキを共有するためのコードを扱うAPIのハンドラー
+type deckCodeHandler struct {
+	deckCodeUseCase deckUseCase.IDeckCodeUseCase
+}
+
+func NewDeckCodeHandler(deckCodeUseCase deckUseCase.IDeckCodeUseCase) *deckCodeHandler {
+	return &deckCodeHandler{
+		deckCodeUseCase: deckCodeUseCase,
+	}
+}
+
+// GetDeckCode godoc
+// @Summary Get the share code of a saved deck
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Success 200 {object} getDeckCodeResponse
+// @Router /v1/decks/{id}/code [get]
+func (h *deckCodeHandler) GetDeckCode(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	code, err := h.deckCodeUseCase.GetCode(c.Request().Context(), deckId)
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"result": false,
+				"error":  "デッキが見つかりません",
+			})
+		}
+		if errors.Is(err, deckcode.ErrInvalidCode) {
+			return c.JSON(http.StatusUnprocessableEntity, map[string]interface{}{
+				"result": false,
+				"error":  "このデッキはコードにできません: " + err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":    true,
+		"deck_code": code,
+	})
+}
+
+// CreateDeckFromCode godoc
+// @Summary Create a deck from a share code
+// @Tags deck
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body createDeckFromCodeRequest true "Share code and deck info"
+// @Success 200 {object} createDeckResponse
+// @Failure 400 {object} createDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 422 {object} deckValidationFailedResponse
+// @Router /v1/decks/code [post]
+func (h *deckCodeHandler) CreateDeckFromCode(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
+
+	var req createDeckFromCodeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	deck, err := h.deckCodeUseCase.CreateFromCode(c.Request().Context(), &deckUseCase.CreateDeckFromCodeRequestDto{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		Format:      req.Format,
+		OwnerID:     userId,
+	})
+	if err != nil {
+		if errors.Is(err, deckcode.ErrInvalidCode) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  "デッキコードが正しくありません: " + err.Error(),
+			})
+		}
+		// 作成時にデッキは参照しないため、見つからないのはコードに含まれるカード
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  "デッキコードに存在しないカードが含まれています",
+			})
+		}
+		return deckErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"deck":   deck,
+	})
+}
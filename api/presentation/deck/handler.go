@@ -2,6 +2,10 @@ package deck
 
 import (
 	deckUseCase "api/application/deck"
+	"api/application/translation"
+	domainDeck "api/domain/deck"
+	domainErr "api/domain/error"
+	authMiddleware "api/presentation/middleware"
 	"errors"
 	"net/http"
 	"strconv"
@@ -15,6 +19,7 @@ type deckHandler struct {
 	validateDeckUseCase deckUseCase.IValidateDeckUseCase
 	updateDeckUseCase   deckUseCase.IUpdateDeckUseCase
 	deleteDeckUseCase   deckUseCase.IDeleteDeckUseCase
+	translateUseCase    *translation.TranslateUseCase
 }
 
 func NewDeckHandler(
@@ -23,6 +28,7 @@ func NewDeckHandler(
 	validateDeckUseCase deckUseCase.IValidateDeckUseCase,
 	updateDeckUseCase deckUseCase.IUpdateDeckUseCase,
 	deleteDeckUseCase deckUseCase.IDeleteDeckUseCase,
+	translateUseCase *translation.TranslateUseCase,
 ) *deckHandler {
 	return &deckHandler{
 		listDeckUseCase:     listDeckUseCase,
@@ -30,31 +36,115 @@ func NewDeckHandler(
 		validateDeckUseCase: validateDeckUseCase,
 		updateDeckUseCase:   updateDeckUseCase,
 		deleteDeckUseCase:   deleteDeckUseCase,
+		translateUseCase:    translateUseCase,
 	}
 }
 
 // GetUserDecks godoc
 // @Summary Get user decks
+// @Description Returns a page of the user's decks. Pass next_cursor as cursor to get the next page.
 // @Tags deck
 // @Accept json
 // @Produce json
-// Removed @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param name query string false "Deck name contains"
+// @Param format query string false "Format such as standard"
+// @Param card_id query int false "Card contained in the deck"
+// @Param card_category query string false "Category of card_id (pokemon, trainer, energy)"
+// @Param main_card_id query int false "Main card of the deck"
+// @Param main_card_category query string false "Category of main_card_id (pokemon, trainer, energy)"
+// @Param sort query string false "created_at (default), updated_at or name"
+// @Param order query string false "asc or desc (default: desc for dates, asc for name)"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param view query string false "full (default) or summary to omit the card list"
+// @Param include_unowned query bool false "Also list decks created before accounts existed, which have no owner"
+// @Param lang query string false "Card name language, ja (default) or en. Defaults to Accept-Language"
 // @Success 200 {object} getUserDecksResponse
+// @Failure 400 {object} errorResponse
+// @Failure 401 {object} errorResponse
 // @Router /v1/decks [get]
 func (h *deckHandler) GetAllDecks(c echo.Context) error {
-	// ユースケースを実行
-	decks, err := h.listDeckUseCase.GetAllDecks(c.Request().Context())
+	userId, err := authMiddleware.GetUserId(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		return unauthorizedResponse(c)
+	}
+
+	var req listDecksRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"result": false,
-			"error":  err.Error(),
+			"error":  "Invalid request",
+		})
+	}
+
+	requestDto := &deckUseCase.ListDeckRequestDto{
+		Name:   req.Name,
+		Format: req.Format,
+		Sort:   req.Sort,
+		Order:  req.Order,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+		// 持ち主のいないデッキは指定した場合だけ含める
+		IncludeUnowned: req.IncludeUnowned,
+	}
+	if req.CardID != 0 {
+		requestDto.Card = &deckUseCase.CardIDDto{Id: req.CardID, Category: req.CardCategory}
+	}
+	if req.MainCardID != 0 {
+		requestDto.MainCard = &deckUseCase.CardIDDto{Id: req.MainCardID, Category: req.MainCardCategory}
+	}
+
+	// ユースケースを実行
+	var result interface{}
+	var nextCursor string
+	switch req.View {
+	case "", "full":
+		page, err := h.listDeckUseCase.GetAllDecks(c.Request().Context(), userId, requestDto)
+		if err != nil {
+			return listDecksErrorResponse(c, err)
+		}
+		if err := h.translateDecks(c, page.Decks); err != nil {
+			return listDecksErrorResponse(c, err)
+		}
+		result, nextCursor = page.Decks, page.NextCursor
+	case "summary":
+		page, err := h.listDeckUseCase.GetDeckSummaries(c.Request().Context(), userId, requestDto)
+		if err != nil {
+			return listDecksErrorResponse(c, err)
+		}
+		if err := h.translateSummaries(c, page.Decks); err != nil {
+			return listDecksErrorResponse(c, err)
+		}
+		result, nextCursor = page.Decks, page.NextCursor
+	default:
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "view は full か summary を指定してください",
 		})
 	}
 
 	// レスポンスを生成
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"result": true,
-		"decks":  decks,
+		"result":      true,
+		"decks":       result,
+		"next_cursor": nextCursor,
+	})
+}
+
+func listDecksErrorResponse(c echo.Context, err error) error {
+	if errors.Is(err, deckUseCase.ErrInvalidListParams) ||
+		errors.Is(err, deckUseCase.ErrInvalidCardCategory) ||
+		errors.Is(err, deckUseCase.ErrInvalidMainCardCategory) ||
+		errors.Is(err, domainDeck.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"result": false,
+		"error":  err.Error(),
 	})
 }
 
@@ -63,10 +153,17 @@ func (h *deckHandler) GetAllDecks(c echo.Context) error {
 // @Tags deck
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param request body createDeckRequest true "Deck information"
 // @Success 200 {object} createDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 422 {object} deckValidationFailedResponse
 // @Router /v1/decks/create [post]
 func (h *deckHandler) CreateDeck(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
 
 	var req createDeckRequest
 	if err := c.Bind(&req); err != nil {
@@ -81,6 +178,8 @@ func (h *deckHandler) CreateDeck(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Cards:       make([]deckUseCase.DeckCardRequestDto, 0, len(req.Cards)),
+		Format:      req.Format,
+		OwnerID:     userId,
 	}
 
 	// メインカードとサブカードがある場合は設定
@@ -110,10 +209,7 @@ func (h *deckHandler) CreateDeck(c echo.Context) error {
 	// ユースケースを実行
 	deck, err := h.createDeckUseCase.Execute(c.Request().Context(), requestDto)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"result": false,
-			"error":  err.Error(),
-		})
+		return deckErrorResponse(c, err)
 	}
 
 	// レスポンスを生成
@@ -147,6 +243,8 @@ func (h *deckHandler) ValidateDeck(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Cards:       make([]deckUseCase.DeckCardRequestDto, 0, len(req.Cards)),
+		Format:      req.Format,
+		Ruleset:     req.Ruleset,
 	}
 
 	// メインカードとサブカードがある場合は設定
@@ -176,19 +274,7 @@ func (h *deckHandler) ValidateDeck(c echo.Context) error {
 	// ユースケースを実行
 	result, err := h.validateDeckUseCase.Execute(c.Request().Context(), requestDto)
 	if err != nil {
-		// エラーメッセージからHTTPステータスを判断
-		if errors.Is(err, errors.New("invalid main card category")) ||
-			errors.Is(err, errors.New("invalid sub card category")) ||
-			errors.Is(err, errors.New("invalid card category")) {
-			return c.JSON(http.StatusBadRequest, map[string]interface{}{
-				"result": false,
-				"error":  err.Error(),
-			})
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"result": false,
-			"error":  err.Error(),
-		})
+		return deckErrorResponse(c, err)
 	}
 
 	// レスポンスを生成
@@ -196,6 +282,7 @@ func (h *deckHandler) ValidateDeck(c echo.Context) error {
 		"result":   true,
 		"is_valid": result.IsValid,
 		"errors":   result.Errors,
+		"warnings": result.Warnings,
 	})
 }
 
@@ -205,10 +292,18 @@ func (h *deckHandler) ValidateDeck(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Deck ID"
+// @Security BearerAuth
 // @Param request body updateDeckRequest true "Deck information"
 // @Success 200 {object} updateDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
+// @Failure 422 {object} deckValidationFailedResponse
 // @Router /v1/decks/edit/{id} [post]
 func (h *deckHandler) UpdateDeck(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
 
 	// デッキIDをパスパラメータから取得
 	deckIdStr := c.Param("id")
@@ -234,6 +329,8 @@ func (h *deckHandler) UpdateDeck(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Cards:       make([]deckUseCase.DeckCardRequestDto, 0, len(req.Cards)),
+		Format:      req.Format,
+		UserID:      userId,
 	}
 
 	// メインカードとサブカードがある場合は設定
@@ -263,16 +360,13 @@ func (h *deckHandler) UpdateDeck(c echo.Context) error {
 	// ユースケースを実行
 	deck, err := h.updateDeckUseCase.Execute(c.Request().Context(), deckId, requestDto)
 	if err != nil {
-		if errors.Is(err, errors.New("デッキが見つかりません")) {
+		if errors.Is(err, domainErr.NotFoundErr) {
 			return c.JSON(http.StatusNotFound, map[string]interface{}{
 				"result": false,
 				"error":  err.Error(),
 			})
 		}
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"result": false,
-			"error":  err.Error(),
-		})
+		return deckErrorResponse(c, err)
 	}
 
 	// レスポンスを生成
@@ -288,9 +382,16 @@ func (h *deckHandler) UpdateDeck(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Deck ID"
+// @Security BearerAuth
 // @Success 200 {object} deleteDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
 // @Router /v1/decks/delete/{id} [delete]
 func (h *deckHandler) DeleteDeck(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
 
 	deckIdStr := c.Param("id")
 	deckId, err := strconv.Atoi(deckIdStr)
@@ -301,8 +402,11 @@ func (h *deckHandler) DeleteDeck(c echo.Context) error {
 		})
 	}
 
-	err = h.deleteDeckUseCase.DeleteDeck(c.Request().Context(), deckId)
+	err = h.deleteDeckUseCase.DeleteDeck(c.Request().Context(), deckId, userId)
 	if err != nil {
+		if errors.Is(err, domainDeck.ErrNotDeckOwner) {
+			return forbiddenResponse(c)
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"result": false,
 			"error":  err.Error(),
@@ -320,6 +424,7 @@ func (h *deckHandler) DeleteDeck(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Deck ID"
+// @Param lang query string false "Card name language, ja (default) or en. Defaults to Accept-Language"
 // @Success 200 {object} getDeckByIdResponse
 // @Router /v1/decks/detail/{id} [get]
 func (h *deckHandler) GetDeckById(c echo.Context) error {
@@ -334,7 +439,7 @@ func (h *deckHandler) GetDeckById(c echo.Context) error {
 
 	deck, err := h.listDeckUseCase.GetDeckById(c.Request().Context(), deckId)
 	if err != nil {
-		if errors.Is(err, errors.New("デッキが見つかりません")) {
+		if errors.Is(err, domainErr.NotFoundErr) {
 			return c.JSON(http.StatusNotFound, map[string]interface{}{
 				"result": false,
 				"error":  "デッキが見つかりません",
@@ -345,6 +450,12 @@ func (h *deckHandler) GetDeckById(c echo.Context) error {
 			"error":  err.Error(),
 		})
 	}
+	if err := h.translateDecks(c, []*deckUseCase.DeckDto{deck}); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
 
 	// レスポンスを生成
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -352,3 +463,50 @@ func (h *deckHandler) GetDeckById(c echo.Context) error {
 		"deck":   deck,
 	})
 }
+
+// deckErrorResponse ユースケースのエラーをHTTPステータスに振り分ける
+func deckErrorResponse(c echo.Context, err error) error {
+	var validationErr *deckUseCase.DeckValidationFailedError
+	if errors.As(err, &validationErr) {
+		// 検証APIと同じ形で違反内容を返す
+		return c.JSON(http.StatusUnprocessableEntity, map[string]interface{}{
+			"result":   false,
+			"error":    err.Error(),
+			"is_valid": false,
+			"errors":   validationErr.Errors,
+			"warnings": validationErr.Warnings,
+		})
+	}
+	if errors.Is(err, deckUseCase.ErrInvalidMainCardCategory) ||
+		errors.Is(err, deckUseCase.ErrInvalidSubCardCategory) ||
+		errors.Is(err, deckUseCase.ErrInvalidCardCategory) ||
+		errors.Is(err, domainDeck.ErrUnknownFormat) ||
+		errors.Is(err, domainDeck.ErrUnknownRuleset) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+	if errors.Is(err, domainDeck.ErrNotDeckOwner) {
+		return forbiddenResponse(c)
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"result": false,
+		"error":  err.Error(),
+	})
+}
+
+// 認証ミドルウェアを通っていない、またはトークンにユーザーIDがない
+func unauthorizedResponse(c echo.Context) error {
+	return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+		"result": false,
+		"error":  "ログインが必要です",
+	})
+}
+
+func forbiddenResponse(c echo.Context) error {
+	return c.JSON(http.StatusForbidden, map[string]interface{}{
+		"result": false,
+		"error":  "他のユーザーのデッキは編集できません",
+	})
+}
@@ -2,6 +2,7 @@ package deck
 
 import (
 	deckUseCase "api/application/deck"
+	domainDeck "api/domain/deck"
 	"context"
 	"encoding/json"
 	"errors"
@@ -51,12 +52,20 @@ type mockListDeckUseCase struct {
 	mock.Mock
 }
 
-func (m *mockListDeckUseCase) GetAllDecks(ctx context.Context) ([]*deckUseCase.DeckDto, error) {
-	args := m.Called(ctx)
+func (m *mockListDeckUseCase) GetAllDecks(ctx context.Context, userId string, request *deckUseCase.ListDeckRequestDto) (*deckUseCase.DeckPageDto, error) {
+	args := m.Called(ctx, userId, request)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*deckUseCase.DeckDto), args.Error(1)
+	return args.Get(0).(*deckUseCase.DeckPageDto), args.Error(1)
+}
+
+func (m *mockListDeckUseCase) GetDeckSummaries(ctx context.Context, userId string, request *deckUseCase.ListDeckRequestDto) (*deckUseCase.DeckSummaryPageDto, error) {
+	args := m.Called(ctx, userId, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*deckUseCase.DeckSummaryPageDto), args.Error(1)
 }
 
 func (m *mockListDeckUseCase) GetDeckById(ctx context.Context, id int) (*deckUseCase.DeckDto, error) {
@@ -96,8 +105,8 @@ type mockDeleteDeckUseCase struct {
 	mock.Mock
 }
 
-func (m *mockDeleteDeckUseCase) DeleteDeck(ctx context.Context, id int) error {
-	args := m.Called(ctx, id)
+func (m *mockDeleteDeckUseCase) DeleteDeck(ctx context.Context, id int, userId string) error {
+	args := m.Called(ctx, id, userId)
 	if args.Get(0) == nil {
 		return args.Error(1)
 	}
@@ -188,12 +197,21 @@ func TestCreateDeck(t *testing.T) {
 			expectedResult:     true,
 		},
 		"invalid_request": {
-			requestBody:        `{"name": ""}`,
+			requestBody:        `{"name": `,
 			mockReturn:         nil,
 			mockError:          nil,
 			expectedStatusCode: http.StatusBadRequest,
 			expectedResult:     false,
 		},
+		"name_required": {
+			requestBody: `{"name": ""}`,
+			mockReturn:  nil,
+			mockError: &deckUseCase.DeckValidationFailedError{
+				Errors: []deckUseCase.ViolationDto{{Code: string(domainDeck.CodeNameRequired), Severity: "error"}},
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedResult:     false,
+		},
 		"server_error": {
 			requestBody: `{
 				"name": "テストデッキ",
@@ -216,7 +234,7 @@ func TestCreateDeck(t *testing.T) {
 				"cards": []
 			}`,
 			mockReturn:         nil,
-			mockError:          errors.New("invalid main card category"),
+			mockError:          deckUseCase.ErrInvalidMainCardCategory,
 			expectedStatusCode: http.StatusBadRequest,
 			expectedResult:     false,
 		},
@@ -243,12 +261,12 @@ func TestCreateDeck(t *testing.T) {
 				mockDeleteDeckUseCase := new(mockDeleteDeckUseCase)
 
 				// モックの振る舞いを設定（正しいパッケージパスとジェネリックな引数を指定）
-				if tt.requestBody != `{"name": ""}` { // 無効なリクエストの場合はモックは呼び出されない
+				if tt.requestBody != `{"name": ` { // 無効なリクエストの場合はモックは呼び出されない
 					mockCreateDeckUC.On("Execute", mock.Anything, mock.AnythingOfType("*deck.CreateDeckRequestDto")).Return(tt.mockReturn, tt.mockError)
 				}
 
 				// ハンドラーの作成
-				handler := NewDeckHandler(mockListDeckUC, mockCreateDeckUC, mockValidateDeckUC, mockUpdateDeckUseCase, mockDeleteDeckUseCase)
+				handler := NewDeckHandler(mockListDeckUC, mockCreateDeckUC, mockValidateDeckUC, mockUpdateDeckUseCase, mockDeleteDeckUseCase, nil)
 
 				// テスト対象の関数を呼び出し
 				return handler.CreateDeck(c)
@@ -286,3 +304,53 @@ func TestCreateDeck(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteDeck(t *testing.T) {
+	tests := map[string]struct {
+		authenticated      bool
+		mockError          error
+		expectedStatusCode int
+	}{
+		"success": {
+			authenticated:      true,
+			expectedStatusCode: http.StatusOK,
+		},
+		"unauthorized": {
+			authenticated:      false,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		"not_owner": {
+			authenticated:      true,
+			mockError:          domainDeck.ErrNotDeckOwner,
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/decks/delete/1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			mockDeleteDeckUC := new(mockDeleteDeckUseCase)
+			mockDeleteDeckUC.On("DeleteDeck", mock.Anything, 1, "test-user-id").Return(nil, tt.mockError)
+			handler := NewDeckHandler(new(mockListDeckUseCase), new(mockCreateDeckUseCase), new(mockValidateDeckUseCase), new(mockUpdateDeckUseCase), mockDeleteDeckUC, nil)
+
+			h := handler.DeleteDeck
+			if tt.authenticated {
+				h = (&mockAuthMiddleware{}).GetAuthMiddleware()(handler.DeleteDeck)
+			}
+			if err := h(c); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			if !tt.authenticated {
+				mockDeleteDeckUC.AssertNotCalled(t, "DeleteDeck", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
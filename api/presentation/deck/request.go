@@ -1,5 +1,23 @@
 package deck
 
+// GetUserDecks Request
+type listDecksRequest struct {
+	Name             string `query:"name"`
+	Format           string `query:"format"`
+	CardID           int    `query:"card_id"`
+	CardCategory     string `query:"card_category"`
+	MainCardID       int    `query:"main_card_id"`
+	MainCardCategory string `query:"main_card_category"`
+	Sort             string `query:"sort"`
+	Order            string `query:"order"`
+	Cursor           string `query:"cursor"`
+	Limit            int    `query:"limit"`
+	// full か summary
+	View string `query:"view"`
+	// 持ち主のいないデッキも含める
+	IncludeUnowned bool `query:"include_unowned"`
+}
+
 // CreateDeck Request
 type createDeckRequest struct {
 	Name        string            `json:"name"`
@@ -7,6 +25,7 @@ type createDeckRequest struct {
 	MainCard    *cardIDRequest    `json:"main_card,omitempty"`
 	SubCard     *cardIDRequest    `json:"sub_card,omitempty"`
 	Cards       []deckCardRequest `json:"cards"`
+	Format      string            `json:"format,omitempty"`
 }
 
 type cardIDRequest struct {
@@ -27,6 +46,8 @@ type validateDeckRequest struct {
 	MainCard    *cardIDRequest    `json:"main_card,omitempty"`
 	SubCard     *cardIDRequest    `json:"sub_card,omitempty"`
 	Cards       []deckCardRequest `json:"cards"`
+	Format      string            `json:"format,omitempty"`
+	Ruleset     string            `json:"ruleset,omitempty"`
 }
 
 type updateDeckRequest struct {
@@ -35,4 +56,57 @@ type updateDeckRequest struct {
 	MainCard    *cardIDRequest    `json:"main_card,omitempty"`
 	SubCard     *cardIDRequest    `json:"sub_card,omitempty"`
 	Cards       []deckCardRequest `json:"cards"`
+	Format      string            `json:"format,omitempty"`
+}
+
+// GetProbabilities Request
+type deckProbabilityRequest struct {
+	Turn    *int     `query:"turn"`
+	AtLeast *int     `query:"at_least"`
+	Groups  []string `query:"group"`
+}
+
+// Simulate Request
+type simulateDeckRequest struct {
+	Iterations int                   `json:"iterations"`
+	Turns      int                   `json:"turns"`
+	Seed       *uint64               `json:"seed,omitempty"`
+	Searches   []searchEffectRequest `json:"searches"`
+	Targets    []cardGroupRequest    `json:"targets"`
+}
+
+// 手札に来たら使用して、targetsの中からcount枚を山札から手札に加えるカード
+type searchEffectRequest struct {
+	CardName string   `json:"card_name"`
+	Targets  []string `json:"targets"`
+	Count    int      `json:"count"`
+}
+
+type cardGroupRequest struct {
+	Name      string   `json:"name"`
+	CardNames []string `json:"card_names"`
+}
+
+// DiffVersions Request
+type diffDeckVersionsRequest struct {
+	From int `query:"from"`
+	To   int `query:"to"`
+}
+
+// ImportDeck Request
+type importDeckRequest struct {
+	Text        string `json:"text" validate:"required"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Format      string `json:"format,omitempty"`
+	// trueの場合、すべての行を取り込めたらデッキを作成する
+	Save bool `json:"save"`
+}
+
+// CreateDeckFromCode Request
+type createDeckFromCodeRequest struct {
+	Code        string `json:"code" validate:"required"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Format      string `json:"format,omitempty"`
 }
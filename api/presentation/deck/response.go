@@ -1,9 +1,19 @@
 package deck
 
+import deckUseCase "api/application/deck"
+
 // GetUserDecks Response
 type getUserDecksResponse struct {
 	Result bool        `json:"result"`
 	Decks  interface{} `json:"decks"`
+	// 次のページがない場合は空
+	NextCursor string `json:"next_cursor"`
+}
+
+// 認証・権限エラーの Response
+type errorResponse struct {
+	Result bool   `json:"result"`
+	Error  string `json:"error"`
 }
 
 // CreateDeck Response
@@ -14,9 +24,19 @@ type createDeckResponse struct {
 
 // ValidateDeck Response
 type validateDeckResponse struct {
-	Result  bool     `json:"result"`
-	IsValid bool     `json:"is_valid"`
-	Errors  []string `json:"errors,omitempty"`
+	Result   bool                       `json:"result"`
+	IsValid  bool                       `json:"is_valid"`
+	Errors   []deckUseCase.ViolationDto `json:"errors,omitempty"`
+	Warnings []deckUseCase.ViolationDto `json:"warnings,omitempty"`
+}
+
+// CreateDeck・UpdateDeck でデッキがルールを満たしていない場合の Response
+type deckValidationFailedResponse struct {
+	Result   bool                       `json:"result"`
+	Error    string                     `json:"error"`
+	IsValid  bool                       `json:"is_valid"`
+	Errors   []deckUseCase.ViolationDto `json:"errors"`
+	Warnings []deckUseCase.ViolationDto `json:"warnings,omitempty"`
 }
 
 // UpdateDeck Response
@@ -39,3 +59,51 @@ type deleteDeckResponse struct {
 	Message string `json:"message,omitempty"`
 	Error   string `json:"error,omitempty"`
 }
+
+// GetProbabilities Response
+type deckProbabilityResponse struct {
+	Result        bool                                    `json:"result"`
+	Probabilities *deckUseCase.DeckProbabilityResponseDto `json:"probabilities"`
+}
+
+// Simulate Response
+type simulateDeckResponse struct {
+	Result     bool                                 `json:"result"`
+	Simulation *deckUseCase.SimulateDeckResponseDto `json:"simulation"`
+}
+
+// GetVersions Response
+type getDeckVersionsResponse struct {
+	Result   bool                         `json:"result"`
+	Versions []deckUseCase.DeckVersionDto `json:"versions"`
+}
+
+// GetVersion Response
+type getDeckVersionResponse struct {
+	Result  bool                              `json:"result"`
+	Version *deckUseCase.DeckVersionDetailDto `json:"version"`
+}
+
+// DiffVersions Response
+type diffDeckVersionsResponse struct {
+	Result bool                     `json:"result"`
+	Diff   *deckUseCase.DeckDiffDto `json:"diff"`
+}
+
+// ImportDeck Response
+type importDeckResponse struct {
+	Result bool                               `json:"result"`
+	Import *deckUseCase.ImportDeckResponseDto `json:"import"`
+}
+
+// ExportDeck Response
+type exportDeckResponse struct {
+	Result bool                               `json:"result"`
+	Export *deckUseCase.ExportDeckResponseDto `json:"export"`
+}
+
+// GetDeckCode Response
+type getDeckCodeResponse struct {
+	Result   bool                     `json:"result"`
+	DeckCode *deckUseCase.DeckCodeDto `json:"deck_code"`
+}
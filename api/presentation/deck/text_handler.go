@@ -0,0 +1,127 @@
+package deck
+
+import (
+	deckUseCase "api/application/deck"
+	domainErr "api/domain/error"
+	"api/pkg/validator"
+	authMiddleware "api/presentation/middleware"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PTCG Liveなどのテキスト形式でデッキを取り込み・書き出しするAPIのハンドラー
+type deckTextHandler struct {
+	importDeckUseCase deckUseCase.IImportDeckUseCase
+	exportDeckUseCase deckUseCase.IExportDeckUseCase
+}
+
+func NewDeckTextHandler(
+	importDeckUseCase deckUseCase.IImportDeckUseCase,
+	exportDeckUseCase deckUseCase.IExportDeckUseCase,
+) *deckTextHandler {
+	return &deckTextHandler{
+		importDeckUseCase: importDeckUseCase,
+		exportDeckUseCase: exportDeckUseCase,
+	}
+}
+
+// ImportDeck godoc
+// @Summary Import a deck from a PTCG Live text list
+// @Tags deck
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body importDeckRequest true "Deck list text"
+// @Success 200 {object} importDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 422 {object} deckValidationFailedResponse
+// @Router /v1/decks/import [post]
+func (h *deckTextHandler) ImportDeck(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
+
+	var req importDeckRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	result, err := h.importDeckUseCase.Execute(c.Request().Context(), &deckUseCase.ImportDeckRequestDto{
+		Text:        req.Text,
+		Name:        req.Name,
+		Description: req.Description,
+		Format:      req.Format,
+		Save:        req.Save,
+		OwnerID:     userId,
+	})
+	if err != nil {
+		if errors.Is(err, deckUseCase.ErrEmptyDeckList) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"result": false,
+				"error":  "デッキリストにカードがありません",
+			})
+		}
+		return deckErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"import": result,
+	})
+}
+
+// ExportDeck godoc
+// @Summary Export a saved deck as a PTCG Live text list
+// @Description Returns plain text when the Accept header asks for text/plain.
+// @Tags deck
+// @Produce json,plain
+// @Param id path int true "Deck ID"
+// @Success 200 {object} exportDeckResponse
+// @Router /v1/decks/{id}/export [get]
+func (h *deckTextHandler) ExportDeck(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	result, err := h.exportDeckUseCase.Execute(c.Request().Context(), deckId)
+	if err != nil {
+		if errors.Is(err, domainErr.NotFoundErr) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"result": false,
+				"error":  "デッキが見つかりません",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	// Discordなどにそのまま貼り付けられるようにテキストでも返す
+	if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMETextPlain) {
+		return c.String(http.StatusOK, result.Text)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"export": result,
+	})
+}
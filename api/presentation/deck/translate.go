@@ -0,0 +1,66 @@
+package deck
+
+import (
+	deckUseCase "api/application/deck"
+	"api/application/translation"
+	authMiddleware "api/presentation/middleware"
+
+	"github.com/labstack/echo/v4"
+)
+
+// translateDecks カード名をリクエストの言語にする。デッキ名と説明はユーザーが書いたものなので訳さない
+func (h *deckHandler) translateDecks(c echo.Context, decks []*deckUseCase.DeckDto) error {
+	var sources []string
+	for _, d := range decks {
+		sources = append(sources, cardNames(d.MainCard, d.SubCard)...)
+		for _, card := range d.Cards {
+			sources = append(sources, card.Name)
+		}
+	}
+	dict, err := h.translateUseCase.Dictionary(c.Request().Context(), authMiddleware.GetLang(c), sources)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range decks {
+		translateCard(dict, d.MainCard)
+		translateCard(dict, d.SubCard)
+		for i := range d.Cards {
+			d.Cards[i].Name = dict.Text(translation.CardName, d.Cards[i].Name)
+		}
+	}
+	return nil
+}
+
+func (h *deckHandler) translateSummaries(c echo.Context, summaries []*deckUseCase.DeckSummaryDto) error {
+	var sources []string
+	for _, s := range summaries {
+		sources = append(sources, cardNames(s.MainCard, s.SubCard)...)
+	}
+	dict, err := h.translateUseCase.Dictionary(c.Request().Context(), authMiddleware.GetLang(c), sources)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		translateCard(dict, s.MainCard)
+		translateCard(dict, s.SubCard)
+	}
+	return nil
+}
+
+func cardNames(cards ...*deckUseCase.CardDto) []string {
+	var names []string
+	for _, card := range cards {
+		if card != nil {
+			names = append(names, card.Name)
+		}
+	}
+	return names
+}
+
+func translateCard(dict translation.Dictionary, card *deckUseCase.CardDto) {
+	if card != nil {
+		card.Name = dict.Text(translation.CardName, card.Name)
+	}
+}
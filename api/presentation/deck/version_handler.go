@@ -0,0 +1,185 @@
+package deck
+
+import (
+	deckUseCase "api/application/deck"
+	domainErr "api/domain/error"
+	authMiddleware "api/presentation/middleware"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// デッキの変更履歴を扱うAPIのハンドラー
+type deckVersionHandler struct {
+	deckVersionUseCase deckUseCase.IDeckVersionUseCase
+	revertDeckUseCase  deckUseCase.IRevertDeckUseCase
+}
+
+func NewDeckVersionHandler(
+	deckVersionUseCase deckUseCase.IDeckVersionUseCase,
+	revertDeckUseCase deckUseCase.IRevertDeckUseCase,
+) *deckVersionHandler {
+	return &deckVersionHandler{
+		deckVersionUseCase: deckVersionUseCase,
+		revertDeckUseCase:  revertDeckUseCase,
+	}
+}
+
+// GetVersions godoc
+// @Summary List saved versions of a deck
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Success 200 {object} getDeckVersionsResponse
+// @Router /v1/decks/{id}/versions [get]
+func (h *deckVersionHandler) GetVersions(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	versions, err := h.deckVersionUseCase.GetVersions(c.Request().Context(), deckId)
+	if err != nil {
+		return versionErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":   true,
+		"versions": versions,
+	})
+}
+
+// GetVersion godoc
+// @Summary Get a deck as it was at a saved version
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param version path int true "Version"
+// @Success 200 {object} getDeckVersionResponse
+// @Router /v1/decks/{id}/versions/{version} [get]
+func (h *deckVersionHandler) GetVersion(c echo.Context) error {
+	deckId, version, err := parseVersionParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	result, err := h.deckVersionUseCase.GetVersion(c.Request().Context(), deckId, version)
+	if err != nil {
+		return versionErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result":  true,
+		"version": result,
+	})
+}
+
+// DiffVersions godoc
+// @Summary Compare the cards of two saved versions of a deck
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param from query int false "Base version (default: the version before to)"
+// @Param to query int false "Target version (default: latest)"
+// @Success 200 {object} diffDeckVersionsResponse
+// @Router /v1/decks/{id}/versions/diff [get]
+func (h *deckVersionHandler) DiffVersions(c echo.Context) error {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "不正なデッキIDです",
+		})
+	}
+
+	var req diffDeckVersionsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  "Invalid request",
+		})
+	}
+
+	diff, err := h.deckVersionUseCase.Diff(c.Request().Context(), deckId, req.From, req.To)
+	if err != nil {
+		return versionErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"diff":   diff,
+	})
+}
+
+// RevertDeck godoc
+// @Summary Restore a deck to a saved version
+// @Tags deck
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param version path int true "Version"
+// @Security BearerAuth
+// @Success 200 {object} updateDeckResponse
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
+// @Failure 422 {object} deckValidationFailedResponse
+// @Router /v1/decks/{id}/versions/{version}/revert [post]
+func (h *deckVersionHandler) RevertDeck(c echo.Context) error {
+	userId, err := authMiddleware.GetUserId(c)
+	if err != nil {
+		return unauthorizedResponse(c)
+	}
+
+	deckId, version, err := parseVersionParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+
+	deck, err := h.revertDeckUseCase.Execute(c.Request().Context(), deckId, version, userId)
+	if err != nil {
+		return versionErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"result": true,
+		"deck":   deck,
+	})
+}
+
+func parseVersionParams(c echo.Context) (int, int, error) {
+	deckId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, 0, errors.New("不正なデッキIDです")
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		return 0, 0, errors.New("不正なバージョンです")
+	}
+	return deckId, version, nil
+}
+
+func versionErrorResponse(c echo.Context, err error) error {
+	if errors.Is(err, domainErr.NotFoundErr) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+	if errors.Is(err, deckUseCase.ErrInvalidVersionRange) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"result": false,
+			"error":  err.Error(),
+		})
+	}
+	return deckErrorResponse(c, err)
+}
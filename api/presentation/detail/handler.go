@@ -2,8 +2,10 @@ package detail
 
 import (
 	"api/application/detail"
+	"api/application/translation"
 	"api/domain"
 	errDomain "api/domain/error"
+	"api/presentation/middleware"
 	"fmt"
 	"strconv"
 
@@ -12,12 +14,14 @@ import (
 )
 
 type detailHandler struct {
-	detailUseCase *detail.FetchDetailUseCase
+	detailUseCase    *detail.FetchDetailUseCase
+	translateUseCase *translation.TranslateUseCase
 }
 
-func NewDetailHandler(detailUseCase *detail.FetchDetailUseCase) *detailHandler {
+func NewDetailHandler(detailUseCase *detail.FetchDetailUseCase, translateUseCase *translation.TranslateUseCase) *detailHandler {
 	return &detailHandler{
-		detailUseCase: detailUseCase,
+		detailUseCase:    detailUseCase,
+		translateUseCase: translateUseCase,
 	}
 }
 
@@ -28,9 +32,12 @@ func NewDetailHandler(detailUseCase *detail.FetchDetailUseCase) *detailHandler {
 // @Produce json
 // @Param id path int true "id"
 // @Param card_type path string true "card_type"
+// @Param lang query string false "ja (default) or en. Defaults to Accept-Language"
 // @Success 200 {object} getDetailResponse
 // @Router /v1/cards/detail/{card_type}/{id} [get]
 func (h *detailHandler) FetchDetail(c echo.Context) error {
+	ctx := c.Request().Context()
+	lang := middleware.GetLang(c)
 	cardType := c.Param("card_type")
 	id := c.Param("id")
 	iid, err := strconv.Atoi(id)
@@ -40,7 +47,7 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 
 	switch domain.StringToCardType[cardType] {
 	case domain.Pokemon:
-		pokemon, err := h.detailUseCase.FetchPokemonDetail(c.Request().Context(), iid)
+		pokemon, err := h.detailUseCase.FetchPokemonDetail(ctx, iid)
 		if err != nil {
 			if err == errDomain.NotFoundErr {
 				return c.JSON(404, fmt.Sprintf("pokemon id %d not found", iid))
@@ -48,7 +55,18 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 			return c.JSON(500, err.Error())
 		}
 
+		sources := []string{pokemon.Name, pokemon.EnergyType, pokemon.Stage, pokemon.EvolvesFrom, pokemon.Ability}
+		for _, attack := range pokemon.Attacks {
+			sources = append(sources, attack.Name)
+		}
+		dict, err := h.translateUseCase.Dictionary(ctx, lang, sources)
+		if err != nil {
+			return c.JSON(500, err.Error())
+		}
+
+		// 説明文は訳さない
 		attacks := lo.Map(pokemon.Attacks, func(attack detail.PokemonAttack, _ int) PokemonAttack {
+			attack.Name = dict.Text(translation.EffectName, attack.Name)
 			return PokemonAttack(attack)
 		})
 
@@ -56,19 +74,25 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 			Result: true,
 			Pokemon: PokemonCard{
 				Id:                 pokemon.Id,
-				Name:               pokemon.Name,
-				EnergyType:         pokemon.EnergyType,
+				Name:               dict.Text(translation.CardName, pokemon.Name),
+				EnergyType:         dict.Text(translation.Label, pokemon.EnergyType),
 				Hp:                 pokemon.Hp,
-				Ability:            pokemon.Ability,
+				Ability:            dict.Text(translation.EffectName, pokemon.Ability),
 				AbilityDescription: pokemon.AbilityDescription,
 				ImageUrl:           pokemon.ImageUrl,
 				Regulation:         pokemon.Regulation,
 				Expansion:          pokemon.Expansion,
+				Stage:              dict.Text(translation.Label, pokemon.Stage),
+				EvolvesFrom:        dict.Text(translation.CardName, pokemon.EvolvesFrom),
 				Attacks:            attacks,
 			},
 		})
 	case domain.Trainer:
-		trainer, err := h.detailUseCase.FetchTrainerDetail(c.Request().Context(), iid)
+		trainer, err := h.detailUseCase.FetchTrainerDetail(ctx, iid)
+		if err != nil {
+			return c.JSON(500, err.Error())
+		}
+		dict, err := h.translateUseCase.Dictionary(ctx, lang, []string{trainer.Name, trainer.TrainerType})
 		if err != nil {
 			return c.JSON(500, err.Error())
 		}
@@ -77,8 +101,8 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 			Result: true,
 			Trainer: TrainerCard{
 				Id:          trainer.Id,
-				Name:        trainer.Name,
-				TrainerType: trainer.TrainerType,
+				Name:        dict.Text(translation.CardName, trainer.Name),
+				TrainerType: dict.Text(translation.Label, trainer.TrainerType),
 				Description: trainer.Description,
 				ImageUrl:    trainer.ImageUrl,
 				Regulation:  trainer.Regulation,
@@ -87,7 +111,11 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 		})
 
 	case domain.Energy:
-		energy, err := h.detailUseCase.FetchEnergyDetail(c.Request().Context(), iid)
+		energy, err := h.detailUseCase.FetchEnergyDetail(ctx, iid)
+		if err != nil {
+			return c.JSON(500, err.Error())
+		}
+		dict, err := h.translateUseCase.Dictionary(ctx, lang, []string{energy.Name})
 		if err != nil {
 			return c.JSON(500, err.Error())
 		}
@@ -96,7 +124,7 @@ func (h *detailHandler) FetchDetail(c echo.Context) error {
 			Result: true,
 			Energy: EnergyCard{
 				Id:          energy.Id,
-				Name:        energy.Name,
+				Name:        dict.Text(translation.CardName, energy.Name),
 				ImageUrl:    energy.ImageUrl,
 				Description: energy.Description,
 				Regulation:  energy.Regulation,
@@ -25,6 +25,8 @@ type PokemonCard struct {
 	ImageUrl           string          `json:"image_url"`
 	Regulation         string          `json:"regulation"`
 	Expansion          string          `json:"expansion"`
+	Stage              string          `json:"stage"`
+	EvolvesFrom        string          `json:"evolves_from,omitempty"`
 	Attacks            []PokemonAttack `json:"attacks"`
 }
 
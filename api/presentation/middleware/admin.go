@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const AdminTokenHeader = "X-Admin-Token"
+
+// RequireAdminToken 運用向けAPIはヘッダーのトークンで認証する
+// トークンが設定されていない場合は、どのリクエストも通さない
+func RequireAdminToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			given := c.Request().Header.Get(AdminTokenHeader)
+			if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				return c.JSON(http.StatusForbidden, map[string]interface{}{
+					"result": false,
+					"error":  "運用向けAPIのトークンが正しくありません",
+				})
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireAdminToken(t *testing.T) {
+	tests := map[string]struct {
+		token      string
+		header     string
+		wantStatus int
+	}{
+		"valid token":    {token: "secret", header: "secret", wantStatus: http.StatusOK},
+		"wrong token":    {token: "secret", header: "guess", wantStatus: http.StatusForbidden},
+		"missing header": {token: "secret", wantStatus: http.StatusForbidden},
+		"not configured": {token: "", header: "", wantStatus: http.StatusForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			e.DELETE("/admin", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, RequireAdminToken(tt.token))
+
+			req := httptest.NewRequest(http.MethodDelete, "/admin", nil)
+			if tt.header != "" {
+				req.Header.Set(AdminTokenHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	apiKeyUseCase "api/application/apikey"
+	"api/domain/apikey"
+	"api/pkg/ratelimit"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// スクリプトやMCPサーバーからはこのヘッダーでAPIキーを送る
+	ApiKeyHeader = "X-API-Key"
+	// 認証したAPIキーを保存するコンテキストのキー
+	apiKeyContextKey = "api_key"
+)
+
+type ApiKeyMiddleware struct {
+	authenticateApiKeyUseCase apiKeyUseCase.IAuthenticateApiKeyUseCase
+	limiter                   *ratelimit.Limiter
+}
+
+func NewApiKeyMiddleware(authenticateApiKeyUseCase apiKeyUseCase.IAuthenticateApiKeyUseCase, limiter *ratelimit.Limiter) *ApiKeyMiddleware {
+	return &ApiKeyMiddleware{
+		authenticateApiKeyUseCase: authenticateApiKeyUseCase,
+		limiter:                   limiter,
+	}
+}
+
+// GetApiKeyMiddleware APIキーがあれば認証し、キーごとにリクエスト数を制限する。キーがなければ何もしない
+func (m *ApiKeyMiddleware) GetApiKeyMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(ApiKeyHeader)
+			if key == "" {
+				return next(c)
+			}
+
+			found, err := m.authenticateApiKeyUseCase.Execute(c.Request().Context(), key)
+			if err != nil {
+				if errors.Is(err, apiKeyUseCase.ErrInvalidApiKey) {
+					return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+						"result": false,
+						"error":  "APIキーが無効です",
+					})
+				}
+				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+					"result": false,
+					"error":  err.Error(),
+				})
+			}
+
+			if ok, wait := m.limiter.Allow(strconv.Itoa(found.GetId())); !ok {
+				// Retry-Afterは秒単位の整数なので切り上げる
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				return c.JSON(http.StatusTooManyRequests, map[string]interface{}{
+					"result": false,
+					"error":  "リクエストが多すぎます。しばらく待ってから再度お試しください",
+				})
+			}
+
+			c.Set(apiKeyContextKey, found)
+			return next(c)
+		}
+	}
+}
+
+// RequireScope APIキーでのリクエストはスコープを確認する。ログインしたユーザーのリクエストはそのまま通す
+func RequireScope(scope apikey.Scope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if key, ok := getApiKey(c); ok && !key.HasScope(scope) {
+				return c.JSON(http.StatusForbidden, map[string]interface{}{
+					"result": false,
+					"error":  "APIキーに" + string(scope) + "のスコープがありません",
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
+// IsApiKeyRequest APIキーで認証したリクエストか
+func IsApiKeyRequest(c echo.Context) bool {
+	_, ok := getApiKey(c)
+	return ok
+}
+
+func getApiKey(c echo.Context) (*apikey.ApiKey, bool) {
+	key, ok := c.Get(apiKeyContextKey).(*apikey.ApiKey)
+	return key, ok
+}
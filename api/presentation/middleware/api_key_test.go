@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	apiKeyUseCase "api/application/apikey"
+	"api/domain/apikey"
+	"api/pkg/ratelimit"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// 決まったキーだけを受け付ける認証ユースケース
+type stubAuthenticateApiKeyUseCase struct {
+	keys map[string]*apikey.ApiKey
+}
+
+func (s *stubAuthenticateApiKeyUseCase) Execute(ctx context.Context, key string) (*apikey.ApiKey, error) {
+	found, ok := s.keys[key]
+	if !ok {
+		return nil, apiKeyUseCase.ErrInvalidApiKey
+	}
+	return found, nil
+}
+
+func newTestApiKey(t *testing.T, id int, scopes ...apikey.Scope) *apikey.ApiKey {
+	t.Helper()
+	key, err := apikey.NewApiKey(id, "test-user-id", "script", "ptcg_abcdefg", "hash", scopes, nil, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create api key: %v", err)
+	}
+	return key
+}
+
+func TestApiKeyMiddleware(t *testing.T) {
+	useCase := &stubAuthenticateApiKeyUseCase{keys: map[string]*apikey.ApiKey{
+		"search-key": newTestApiKey(t, 1, apikey.ScopeSearchRead),
+		"deck-key":   newTestApiKey(t, 2, apikey.ScopeDeckWrite),
+	}}
+	// 1キーあたり2リクエストまで。テスト中には補充されない
+	limiter := ratelimit.NewLimiter(0.001, 2)
+
+	e := echo.New()
+	e.Use(NewApiKeyMiddleware(useCase, limiter).GetApiKeyMiddleware())
+	e.GET("/search", func(c echo.Context) error {
+		userId, err := GetUserId(c)
+		if err != nil {
+			return c.String(http.StatusOK, "anonymous")
+		}
+		return c.String(http.StatusOK, userId)
+	}, RequireScope(apikey.ScopeSearchRead))
+
+	request := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/search", nil)
+		if key != "" {
+			req.Header.Set(ApiKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("without key", func(t *testing.T) {
+		rec := request("")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "anonymous", rec.Body.String())
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request("unknown-key").Code)
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, request("deck-key").Code)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			rec := request("search-key")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "test-user-id", rec.Body.String())
+		}
+
+		rec := request("search-key")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+
+		// 他のキーには影響しない(スコープ不足で403になるところまで進む)
+		assert.Equal(t, http.StatusForbidden, request("deck-key").Code)
+	})
+}
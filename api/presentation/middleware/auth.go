@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+	echojwt "github.com/labstack/echo-jwt/v4"
+	"github.com/labstack/echo/v4"
+)
+
+// 検証したトークンを保存するコンテキストのキー
+const userContextKey = "user"
+
+var ErrUnauthorized = errors.New("unauthorized")
+
+type AuthMiddleware struct {
+	secret []byte
+}
+
+func NewAuthMiddleware(secret string) *AuthMiddleware {
+	return &AuthMiddleware{
+		secret: []byte(secret),
+	}
+}
+
+// GetAuthMiddleware Authorization: Bearer のトークンを検証し、コンテキストに保存する。
+// APIキーで認証済みのリクエストはトークンがなくても通す
+func (m *AuthMiddleware) GetAuthMiddleware() echo.MiddlewareFunc {
+	return echojwt.WithConfig(echojwt.Config{
+		Skipper:       IsApiKeyRequest,
+		SigningKey:    m.secret,
+		SigningMethod: jwt.SigningMethodHS256.Alg(),
+		ContextKey:    userContextKey,
+		ErrorHandler: func(c echo.Context, err error) error {
+			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"result": false,
+				"error":  "ログインが必要です",
+			})
+		},
+	})
+}
+
+// GetUserId 認証ミドルウェアを通ったリクエストからユーザーIDを取り出す。APIキーの場合はキーの持ち主
+func GetUserId(c echo.Context) (string, error) {
+	if key, ok := getApiKey(c); ok {
+		return key.GetUserId(), nil
+	}
+	token, ok := c.Get(userContextKey).(*jwt.Token)
+	if !ok {
+		return "", ErrUnauthorized
+	}
+	userId, err := token.Claims.GetSubject()
+	if err != nil || userId == "" {
+		return "", ErrUnauthorized
+	}
+	return userId, nil
+}
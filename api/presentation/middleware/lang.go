@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"api/application/translation"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// レスポンスの言語を保存するコンテキストのキー
+const langContextKey = "lang"
+
+// NegotiateLang lang パラメーター、なければ Accept-Language からレスポンスの言語を決める
+// lang の指定が間違っている場合は、黙って日本語にせず400を返す
+func NegotiateLang() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			lang := translation.NegotiateLang(c.Request().Header.Get("Accept-Language"))
+			if param := c.QueryParam("lang"); param != "" {
+				parsed, err := translation.ParseLang(param)
+				if err != nil {
+					return c.JSON(http.StatusBadRequest, map[string]interface{}{
+						"result": false,
+						"error":  "lang は ja か en を指定してください",
+					})
+				}
+				lang = parsed
+			}
+
+			c.Set(langContextKey, lang)
+			c.Response().Header().Set("Content-Language", string(lang))
+			c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+			return next(c)
+		}
+	}
+}
+
+// GetLang ミドルウェアを通っていないリクエストは日本語
+func GetLang(c echo.Context) translation.Lang {
+	if lang, ok := c.Get(langContextKey).(translation.Lang); ok {
+		return lang
+	}
+	return translation.Ja
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateLang(t *testing.T) {
+	tests := map[string]struct {
+		query          string
+		acceptLanguage string
+		wantStatus     int
+		wantLang       string
+	}{
+		"default":                  {wantStatus: http.StatusOK, wantLang: "ja"},
+		"accept-language":          {acceptLanguage: "en-US,en;q=0.9", wantStatus: http.StatusOK, wantLang: "en"},
+		"query wins over header":   {query: "?lang=ja", acceptLanguage: "en", wantStatus: http.StatusOK, wantLang: "ja"},
+		"query only":               {query: "?lang=en", wantStatus: http.StatusOK, wantLang: "en"},
+		"unsupported query":        {query: "?lang=fr", wantStatus: http.StatusBadRequest},
+		"unsupported header falls": {acceptLanguage: "fr", wantStatus: http.StatusOK, wantLang: "ja"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			e.GET("/cards", func(c echo.Context) error {
+				return c.String(http.StatusOK, string(GetLang(c)))
+			}, NegotiateLang())
+
+			req := httptest.NewRequest(http.MethodGet, "/cards"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantLang, rec.Body.String())
+				assert.Equal(t, tt.wantLang, rec.Header().Get("Content-Language"))
+			}
+		})
+	}
+}
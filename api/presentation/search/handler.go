@@ -3,7 +3,12 @@ package search
 import (
 	card "api/application/search"
 	deck "api/application/search/deck"
+	"api/application/search/effect"
+	"api/application/search/paging"
+	"api/application/translation"
 	"api/domain"
+	"api/presentation/middleware"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -11,60 +16,130 @@ import (
 )
 
 type searchHandler struct {
-	searchCardUseCase *card.SearchPokemonAndTrainerUseCase
-	SearchDeckUseCase *deck.SearchDeckUseCase
+	searchCardUseCase       *card.SearchPokemonAndTrainerUseCase
+	searchRankedCardUseCase *card.SearchRankedCardUseCase
+	SearchDeckUseCase       *deck.SearchDeckUseCase
+	searchEffectUseCase     *effect.SearchEffectUseCase
+	translateUseCase        *translation.TranslateUseCase
 }
 
-func NewSearchHandler(searchCardUseCase *card.SearchPokemonAndTrainerUseCase, searchDeckUseCase *deck.SearchDeckUseCase) searchHandler {
+func NewSearchHandler(
+	searchCardUseCase *card.SearchPokemonAndTrainerUseCase,
+	searchRankedCardUseCase *card.SearchRankedCardUseCase,
+	searchDeckUseCase *deck.SearchDeckUseCase,
+	searchEffectUseCase *effect.SearchEffectUseCase,
+	translateUseCase *translation.TranslateUseCase,
+) searchHandler {
 	return searchHandler{
-		searchCardUseCase: searchCardUseCase,
-		SearchDeckUseCase: searchDeckUseCase,
+		searchCardUseCase:       searchCardUseCase,
+		searchRankedCardUseCase: searchRankedCardUseCase,
+		SearchDeckUseCase:       searchDeckUseCase,
+		searchEffectUseCase:     searchEffectUseCase,
+		translateUseCase:        translateUseCase,
 	}
 }
 
+// dictionary 検索結果に出てくる語の訳を、リクエストの言語で引く
+func (h *searchHandler) dictionary(c echo.Context, sources []string) (translation.Dictionary, error) {
+	return h.translateUseCase.Dictionary(c.Request().Context(), middleware.GetLang(c), sources)
+}
+
 // SearchCardList godoc
 // @Summary Search card list
 // @Tags search
 // @Accept json
 // @Produce json
+// @Param q query string false "Card name"
+// @Param card_type query string false "pokemon, trainer or energy"
+// @Param energy_type query string false "Comma separated energy types (Pokémon only)"
+// @Param hp_min query int false "Minimum HP (Pokémon only)"
+// @Param hp_max query int false "Maximum HP (Pokémon only)"
+// @Param regulation query string false "Comma separated regulation marks"
+// @Param expansion query string false "Comma separated expansion codes"
+// @Param trainer_type query string false "Comma separated trainer types (Trainer only)"
+// @Param ace_spec query bool false "ACE SPEC cards only (true) or excluded (false)"
+// @Param has_ability query bool false "Pokémon with (true) or without (false) an ability"
+// @Param page query int false "Page number starting at 1 (cannot be combined with offset)"
+// @Param offset query int false "Number of hits to skip per card type (max 20000)"
+// @Param limit query int false "Hits per card type (default 10, max 100)"
+// @Param sort query string false "relevance, name, hp or newest (default)"
+// @Param lang query string false "ja (default) or en. Defaults to Accept-Language"
 // @Success 200 {object} getProductsResponse
+// @Failure 400 {object} errorResponse
+// @Failure 503 {object} errorResponse
 // @Router /v1/cards/search [get]
 func (h *searchHandler) SearchCardList(c echo.Context) error {
-	q := c.QueryParam("q")
-	cardType := c.QueryParam("card_type")
+	var req searchCardsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: "Invalid request"})
+	}
+	requestDto, err := req.toDto(middleware.GetLang(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+
 	dto, err := func(cardType string) (*card.SearchPokemonAndTrainerUseCaseDto, error) {
 		switch domain.StringToCardType[cardType] {
 		case domain.Pokemon:
-			return h.searchCardUseCase.SearchPokemonList(c.Request().Context(), q)
+			return h.searchCardUseCase.SearchPokemonList(c.Request().Context(), requestDto)
 		case domain.Trainer:
-			return h.searchCardUseCase.SearchTrainerList(c.Request().Context(), q)
+			return h.searchCardUseCase.SearchTrainerList(c.Request().Context(), requestDto)
 		case domain.Energy:
-			return h.searchCardUseCase.SearchEnergyList(c.Request().Context(), q)
+			return h.searchCardUseCase.SearchEnergyList(c.Request().Context(), requestDto)
 		default:
-			return h.searchCardUseCase.SearchPokemonAndTrainerList(c.Request().Context(), q)
+			return h.searchCardUseCase.SearchPokemonAndTrainerList(c.Request().Context(), requestDto)
 		}
-	}(cardType)
+	}(req.CardType)
+	if errors.Is(err, card.ErrInvalidSearchParams) || errors.Is(err, paging.ErrInvalidPage) {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+	if errors.Is(err, card.ErrSearchUnavailable) {
+		return c.JSON(http.StatusServiceUnavailable, errorResponse{Result: false, Error: err.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	var sources []string
+	for _, p := range dto.Pokemons {
+		sources = append(sources, p.Name, p.EnergyType, p.Stage, p.EvolvesFrom)
+	}
+	for _, t := range dto.Trainers {
+		sources = append(sources, t.Name, t.TrainerType)
+	}
+	for _, e := range dto.Energies {
+		sources = append(sources, e.Name)
+	}
+	dict, err := h.dictionary(c, sources)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 
 	var res searchCardResponse
 	res.Result = true
+	res.Facets = dto.Facets
+	res.EstimatedTotalHits = dto.EstimatedTotalHits
+	res.Offset = dto.Offset
+	res.Limit = dto.Limit
+	res.Sort = dto.Sort
+	res.Backend = dto.Backend
 	for _, dtoPokemon := range dto.Pokemons {
 		res.Pokemons = append(res.Pokemons, &pokemon{
-			ID:         dtoPokemon.ID,
-			Name:       dtoPokemon.Name,
-			EnergyType: dtoPokemon.EnergyType,
-			Hp:         dtoPokemon.Hp,
-			ImageURL:   dtoPokemon.ImageURL,
+			ID:          dtoPokemon.ID,
+			Name:        dict.Text(translation.CardName, dtoPokemon.Name),
+			EnergyType:  dict.Text(translation.Label, dtoPokemon.EnergyType),
+			Hp:          dtoPokemon.Hp,
+			ImageURL:    dtoPokemon.ImageURL,
+			Stage:       dict.Text(translation.Label, dtoPokemon.Stage),
+			EvolvesFrom: dict.Text(translation.CardName, dtoPokemon.EvolvesFrom),
 		})
 	}
 
 	for _, dtoTrainer := range dto.Trainers {
 		res.Trainers = append(res.Trainers, &trainer{
 			ID:          dtoTrainer.ID,
-			Name:        dtoTrainer.Name,
-			TrainerType: dtoTrainer.TrainerType,
+			Name:        dict.Text(translation.CardName, dtoTrainer.Name),
+			TrainerType: dict.Text(translation.Label, dtoTrainer.TrainerType),
 			ImageURL:    dtoTrainer.ImageURL,
 		})
 	}
@@ -72,7 +147,7 @@ func (h *searchHandler) SearchCardList(c echo.Context) error {
 	for _, dtoEnergy := range dto.Energies {
 		res.Energies = append(res.Energies, &energy{
 			ID:          dtoEnergy.ID,
-			Name:        dtoEnergy.Name,
+			Name:        dict.Text(translation.CardName, dtoEnergy.Name),
 			Description: dtoEnergy.Description,
 			ImageURL:    dtoEnergy.ImageURL,
 		})
@@ -81,43 +156,232 @@ func (h *searchHandler) SearchCardList(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// SearchRankedCardList godoc
+// @Summary Search all card types in one list ranked by relevance
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string false "Card name"
+// @Param card_type query string false "pokemon, trainer or energy"
+// @Param energy_type query string false "Comma separated energy types (Pokémon only)"
+// @Param hp_min query int false "Minimum HP (Pokémon only)"
+// @Param hp_max query int false "Maximum HP (Pokémon only)"
+// @Param regulation query string false "Comma separated regulation marks"
+// @Param expansion query string false "Comma separated expansion codes"
+// @Param trainer_type query string false "Comma separated trainer types (Trainer only)"
+// @Param ace_spec query bool false "ACE SPEC cards only (true) or excluded (false)"
+// @Param has_ability query bool false "Pokémon with (true) or without (false) an ability"
+// @Param page query int false "Page number starting at 1 (cannot be combined with offset)"
+// @Param offset query int false "Number of hits to skip (max 20000)"
+// @Param limit query int false "Hits per page (default 10, max 100)"
+// @Param lang query string false "ja (default) or en. Defaults to Accept-Language"
+// @Success 200 {object} searchRankedCardResponse
+// @Failure 400 {object} errorResponse
+// @Failure 503 {object} errorResponse
+// @Router /v1/search/cards/ranked [get]
+func (h *searchHandler) SearchRankedCardList(c echo.Context) error {
+	var req searchCardsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: "Invalid request"})
+	}
+	requestDto, err := req.toDto(middleware.GetLang(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+
+	dto, err := h.searchRankedCardUseCase.SearchRankedCardList(c.Request().Context(), domain.StringToCardType[req.CardType], requestDto)
+	if errors.Is(err, card.ErrInvalidSearchParams) || errors.Is(err, paging.ErrInvalidPage) {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+	if errors.Is(err, card.ErrSearchUnavailable) {
+		return c.JSON(http.StatusServiceUnavailable, errorResponse{Result: false, Error: err.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	var sources []string
+	for _, f := range dto.Cards {
+		sources = append(sources, f.Name, f.EnergyType, f.TrainerType)
+	}
+	dict, err := h.dictionary(c, sources)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := searchRankedCardResponse{
+		Result:             true,
+		Cards:              make([]*rankedCard, 0, len(dto.Cards)),
+		EstimatedTotalHits: dto.EstimatedTotalHits,
+		Offset:             dto.Offset,
+		Limit:              dto.Limit,
+	}
+	for _, f := range dto.Cards {
+		res.Cards = append(res.Cards, &rankedCard{
+			CardType:    f.CardType,
+			ID:          f.ID,
+			Name:        dict.Text(translation.CardName, f.Name),
+			ImageURL:    f.ImageURL,
+			EnergyType:  dict.Text(translation.Label, f.EnergyType),
+			Hp:          f.Hp,
+			TrainerType: dict.Text(translation.Label, f.TrainerType),
+			Description: f.Description,
+			Score:       f.Score,
+		})
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// SearchEffectList godoc
+// @Summary Search Pokémon attacks and abilities by their text
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string false "Words in the attack or ability name, description or damage"
+// @Param kind query string false "attack or ability"
+// @Param damage_min query int false "Minimum damage (attacks only)"
+// @Param damage_max query int false "Maximum damage (attacks only)"
+// @Param energy_max query int false "Maximum number of energies the attack requires (attacks only)"
+// @Param regulation query string false "Comma separated regulation marks"
+// @Param page query int false "Page number starting at 1 (cannot be combined with offset)"
+// @Param offset query int false "Number of hits to skip (max 20000)"
+// @Param limit query int false "Hits per page (default 10, max 100)"
+// @Param sort query string false "relevance (default), name or newest"
+// @Param lang query string false "ja (default) or en. Defaults to Accept-Language"
+// @Success 200 {object} searchEffectResponse
+// @Failure 400 {object} errorResponse
+// @Router /v1/search/effects [get]
+func (h *searchHandler) SearchEffectList(c echo.Context) error {
+	var req searchEffectsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: "Invalid request"})
+	}
+	requestDto, err := req.toDto(middleware.GetLang(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+
+	dto, err := h.searchEffectUseCase.SearchEffectList(c.Request().Context(), requestDto)
+	if errors.Is(err, effect.ErrInvalidSearchParams) || errors.Is(err, paging.ErrInvalidPage) {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	var sources []string
+	for _, f := range dto.Effects {
+		sources = append(sources, f.PokemonName, f.EnergyType, f.Name)
+	}
+	dict, err := h.dictionary(c, sources)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	res := searchEffectResponse{
+		Result:             true,
+		Effects:            make([]*searchedEffect, 0, len(dto.Effects)),
+		EstimatedTotalHits: dto.EstimatedTotalHits,
+		Offset:             dto.Offset,
+		Limit:              dto.Limit,
+		Sort:               dto.Sort,
+	}
+	for _, f := range dto.Effects {
+		res.Effects = append(res.Effects, &searchedEffect{
+			Pokemon: effectPokemon{
+				ID:         f.PokemonID,
+				Name:       dict.Text(translation.CardName, f.PokemonName),
+				EnergyType: dict.Text(translation.Label, f.EnergyType),
+				ImageURL:   f.ImageURL,
+			},
+			Kind:           f.Kind,
+			Name:           dict.Text(translation.EffectName, f.Name),
+			RequiredEnergy: f.RequiredEnergy,
+			Damage:         f.Damage,
+			Description:    f.Description,
+			// 一致した位置は日本語の本文で示す
+			Highlight: effectHighlight{
+				Name:        f.HighlightedName,
+				Description: f.HighlightedDescription,
+			},
+		})
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
 // SearchDeckList godoc
 // @Summary Search deck list
 // @Tags search
 // @Accept json
 // @Produce json
+// @Param q query string false "Keyword"
+// @Param page query int false "Page number starting at 1 (cannot be combined with offset)"
+// @Param offset query int false "Number of hits to skip (max 20000)"
+// @Param limit query int false "Hits per page (default 10, max 100)"
+// @Param sort query string false "relevance, name or newest (default)"
+// @Param lang query string false "ja (default) or en. Defaults to Accept-Language"
 // @Success 200 {object} getDecksResponse
+// @Failure 400 {object} errorResponse
 // @Router /v1/decks/search [get]
 func (h *searchHandler) SearchDeckList(c echo.Context) error {
-	q := c.QueryParam("q")
-	dto, err := h.SearchDeckUseCase.SearchDeckList(c.Request().Context(), q)
+	var req searchDecksRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: "Invalid request"})
+	}
+	dto, err := h.SearchDeckUseCase.SearchDeckList(c.Request().Context(), deck.SearchDeckRequestDto{
+		Q:      req.Q,
+		Page:   req.Page,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+		Sort:   req.Sort,
+	})
+	if errors.Is(err, paging.ErrInvalidPage) {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
+	var sources []string
+	for _, f := range dto.Decks {
+		sources = append(sources, f.MainCard.Name, f.SubCard.Name)
+		for _, card := range f.Cards {
+			sources = append(sources, card.Name)
+		}
+	}
+	dict, err := h.dictionary(c, sources)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
 	var res searchDeckResponse
 	res.Result = true
+	res.EstimatedTotalHits = dto.EstimatedTotalHits
+	res.Offset = dto.Offset
+	res.Limit = dto.Limit
+	res.Sort = dto.Sort
 
-	resultDecks := lo.Map(dto, func(f *deck.SearchDeckUseCaseDto, _ int) *searchedDeck {
+	resultDecks := lo.Map(dto.Decks, func(f *deck.SearchDeckUseCaseDto, _ int) *searchedDeck {
 		return &searchedDeck{
 			ID:          f.Id,
 			Name:        f.Name,
 			Description: f.Description,
+			Format:      f.Format,
 			MainCard: &deckCard{
 				ID:       f.MainCard.Id,
-				Name:     f.MainCard.Name,
+				Name:     dict.Text(translation.CardName, f.MainCard.Name),
 				Category: f.MainCard.Category,
 				ImageURL: f.MainCard.ImageURL,
 			},
 			SubCard: &deckCard{
 				ID:       f.SubCard.Id,
-				Name:     f.SubCard.Name,
+				Name:     dict.Text(translation.CardName, f.SubCard.Name),
 				Category: f.SubCard.Category,
 				ImageURL: f.SubCard.ImageURL,
 			},
 			Cards: lo.Map(f.Cards, func(card deck.SearchDeckCardUseCaseDto, _ int) *deckCard {
 				return &deckCard{
 					ID:       card.Id,
-					Name:     card.Name,
+					Name:     dict.Text(translation.CardName, card.Name),
 					Category: card.Category,
 					Quantity: card.Quantity,
 					ImageURL: card.ImageURL,
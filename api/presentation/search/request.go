@@ -0,0 +1,137 @@
+package search
+
+import (
+	card "api/application/search"
+	"api/application/search/effect"
+	"api/application/translation"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SearchCardList Request
+// 複数の値は energy_type=水,草 のようにカンマ区切りか、energy_type=水&energy_type=草 のように繰り返して指定する
+type searchCardsRequest struct {
+	Q           string   `query:"q"`
+	CardType    string   `query:"card_type"`
+	EnergyType  []string `query:"energy_type"`
+	HpMin       int      `query:"hp_min"`
+	HpMax       int      `query:"hp_max"`
+	Regulation  []string `query:"regulation"`
+	Expansion   []string `query:"expansion"`
+	TrainerType []string `query:"trainer_type"`
+	// true か false。指定しなければ絞り込まない
+	AceSpec    string `query:"ace_spec"`
+	HasAbility string `query:"has_ability"`
+	// 種類ごとに同じ範囲を返す。page(1から)とoffsetはどちらか一方だけ指定する
+	Page   int    `query:"page"`
+	Offset int    `query:"offset"`
+	Limit  int    `query:"limit"`
+	Sort   string `query:"sort"`
+}
+
+// SearchDeckList Request
+type searchDecksRequest struct {
+	Q      string `query:"q"`
+	Page   int    `query:"page"`
+	Offset int    `query:"offset"`
+	Limit  int    `query:"limit"`
+	Sort   string `query:"sort"`
+}
+
+// SearchEffectList Request
+type searchEffectsRequest struct {
+	Q          string   `query:"q"`
+	Kind       string   `query:"kind"`
+	DamageMin  int      `query:"damage_min"`
+	DamageMax  int      `query:"damage_max"`
+	Regulation []string `query:"regulation"`
+	// エネルギー0個のワザで絞り込めるように、指定なしと0を区別する
+	EnergyMax string `query:"energy_max"`
+	Page      int    `query:"page"`
+	Offset    int    `query:"offset"`
+	Limit     int    `query:"limit"`
+	Sort      string `query:"sort"`
+}
+
+// Suggest Request
+type suggestRequest struct {
+	Q     string `query:"q"`
+	Limit int    `query:"limit"`
+}
+
+func (r searchEffectsRequest) toDto(lang translation.Lang) (effect.SearchEffectRequestDto, error) {
+	var energyMax *int
+	if r.EnergyMax != "" {
+		v, err := strconv.Atoi(r.EnergyMax)
+		if err != nil {
+			return effect.SearchEffectRequestDto{}, fmt.Errorf("%w: energy_max は数値で指定してください", effect.ErrInvalidSearchParams)
+		}
+		energyMax = &v
+	}
+
+	return effect.SearchEffectRequestDto{
+		Q:           r.Q,
+		Lang:        lang,
+		Kind:        r.Kind,
+		MinDamage:   r.DamageMin,
+		MaxDamage:   r.DamageMax,
+		MaxEnergy:   energyMax,
+		Regulations: splitValues(r.Regulation),
+		Page:        r.Page,
+		Offset:      r.Offset,
+		Limit:       r.Limit,
+		Sort:        r.Sort,
+	}, nil
+}
+
+func (r searchCardsRequest) toDto(lang translation.Lang) (card.SearchCardRequestDto, error) {
+	aceSpec, err := parseOptionalBool("ace_spec", r.AceSpec)
+	if err != nil {
+		return card.SearchCardRequestDto{}, err
+	}
+	hasAbility, err := parseOptionalBool("has_ability", r.HasAbility)
+	if err != nil {
+		return card.SearchCardRequestDto{}, err
+	}
+
+	return card.SearchCardRequestDto{
+		Q:            r.Q,
+		Lang:         lang,
+		EnergyTypes:  splitValues(r.EnergyType),
+		MinHp:        r.HpMin,
+		MaxHp:        r.HpMax,
+		Regulations:  splitValues(r.Regulation),
+		Expansions:   splitValues(r.Expansion),
+		TrainerTypes: splitValues(r.TrainerType),
+		AceSpec:      aceSpec,
+		HasAbility:   hasAbility,
+		Page:         r.Page,
+		Offset:       r.Offset,
+		Limit:        r.Limit,
+		Sort:         r.Sort,
+	}, nil
+}
+
+func splitValues(params []string) []string {
+	var values []string
+	for _, param := range params {
+		for _, v := range strings.Split(param, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+func parseOptionalBool(name, v string) (*bool, error) {
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s は true か false で指定してください", card.ErrInvalidSearchParams, name)
+	}
+	return &b, nil
+}
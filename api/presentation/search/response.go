@@ -1,18 +1,35 @@
 package search
 
+import card "api/application/search"
+
 type searchCardResponse struct {
 	Result   bool       `json:"result"`
 	Pokemons []*pokemon `json:"pokemons"`
 	Trainers []*trainer `json:"trainers"`
 	Energies []*energy  `json:"energies"`
+	// カードの種類ごとの、絞り込み条件の値ごとの件数
+	Facets card.SearchCardFacetsDto `json:"facets"`
+	// 種類ごとの、範囲を指定しなかった場合の件数
+	EstimatedTotalHits card.SearchCardCountsDto `json:"estimated_total_hits"`
+	Offset             int                      `json:"offset"`
+	Limit              int                      `json:"limit"`
+	Sort               string                   `json:"sort"`
+	Backend            string                   `json:"backend"`
+}
+
+type errorResponse struct {
+	Result bool   `json:"result"`
+	Error  string `json:"error"`
 }
 
 type pokemon struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	Hp         int    `json:"hp"`
-	EnergyType string `json:"energy_type"`
-	ImageURL   string `json:"image_url"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Hp          int    `json:"hp"`
+	EnergyType  string `json:"energy_type"`
+	ImageURL    string `json:"image_url"`
+	Stage       string `json:"stage"`
+	EvolvesFrom string `json:"evolves_from,omitempty"`
 }
 
 type trainer struct {
@@ -29,15 +46,86 @@ type energy struct {
 	ImageURL    string `json:"image_url"`
 }
 
+type searchRankedCardResponse struct {
+	Result             bool          `json:"result"`
+	Cards              []*rankedCard `json:"cards"`
+	EstimatedTotalHits int64         `json:"estimated_total_hits"`
+	Offset             int           `json:"offset"`
+	Limit              int           `json:"limit"`
+}
+
+// rankedCard 種類によって持たない項目は省く
+type rankedCard struct {
+	CardType    string  `json:"card_type"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	ImageURL    string  `json:"image_url"`
+	EnergyType  string  `json:"energy_type,omitempty"`
+	Hp          int     `json:"hp,omitempty"`
+	TrainerType string  `json:"trainer_type,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Score       float64 `json:"score"`
+}
+
+type searchEffectResponse struct {
+	Result             bool              `json:"result"`
+	Effects            []*searchedEffect `json:"effects"`
+	EstimatedTotalHits int64             `json:"estimated_total_hits"`
+	Offset             int               `json:"offset"`
+	Limit              int               `json:"limit"`
+	Sort               string            `json:"sort"`
+}
+
+type searchedEffect struct {
+	Pokemon        effectPokemon   `json:"pokemon"`
+	Kind           string          `json:"kind"`
+	Name           string          `json:"name"`
+	RequiredEnergy string          `json:"required_energy,omitempty"`
+	Damage         string          `json:"damage,omitempty"`
+	Description    string          `json:"description"`
+	Highlight      effectHighlight `json:"highlight"`
+}
+
+type effectPokemon struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	EnergyType string `json:"energy_type"`
+	ImageURL   string `json:"image_url"`
+}
+
+// 一致した部分を<em>で囲んだもの。説明は一致した部分の前後だけに切り詰める
+type effectHighlight struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type suggestResponse struct {
+	Result      bool          `json:"result"`
+	Suggestions []*suggestion `json:"suggestions"`
+}
+
+type suggestion struct {
+	ID       string `json:"id"`
+	CardType string `json:"card_type"`
+	Name     string `json:"name"`
+	// 同じ名前のカードの数。IDは最も新しいカード
+	Prints int `json:"prints"`
+}
+
 type searchDeckResponse struct {
-	Result bool            `json:"result"`
-	Decks  []*searchedDeck `json:"decks"`
+	Result             bool            `json:"result"`
+	Decks              []*searchedDeck `json:"decks"`
+	EstimatedTotalHits int64           `json:"estimated_total_hits"`
+	Offset             int             `json:"offset"`
+	Limit              int             `json:"limit"`
+	Sort               string          `json:"sort"`
 }
 
 type searchedDeck struct {
 	ID          int         `json:"id"`
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
+	Format      string      `json:"format"`
 	MainCard    *deckCard   `json:"main_card"`
 	SubCard     *deckCard   `json:"sub_card"`
 	Cards       []*deckCard `json:"cards"`
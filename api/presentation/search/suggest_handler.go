@@ -0,0 +1,57 @@
+package search
+
+import (
+	"api/application/search/suggest"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+type suggestHandler struct {
+	suggestUseCase *suggest.SuggestUseCase
+}
+
+func NewSuggestHandler(suggestUseCase *suggest.SuggestUseCase) *suggestHandler {
+	return &suggestHandler{
+		suggestUseCase: suggestUseCase,
+	}
+}
+
+// Suggest godoc
+// @Summary Suggest card names while typing
+// @Description Prefix match on card names from an in-memory index. Hiragana and katakana, and upper and lower case, are treated as the same. Reprints with the same name are returned once.
+// @Tags search
+// @Produce json
+// @Param q query string true "Beginning of a card name"
+// @Param limit query int false "Number of suggestions (default 10, max 20)"
+// @Success 200 {object} suggestResponse
+// @Failure 400 {object} errorResponse
+// @Router /v1/search/suggest [get]
+func (h *suggestHandler) Suggest(c echo.Context) error {
+	var req suggestRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: "Invalid request"})
+	}
+
+	dto, err := h.suggestUseCase.Suggest(c.Request().Context(), req.Q, req.Limit)
+	if errors.Is(err, suggest.ErrInvalidSuggestParams) {
+		return c.JSON(http.StatusBadRequest, errorResponse{Result: false, Error: err.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, suggestResponse{
+		Result: true,
+		Suggestions: lo.Map(dto, func(s *suggest.SuggestionDto, _ int) *suggestion {
+			return &suggestion{
+				ID:       s.ID,
+				CardType: s.CardType,
+				Name:     s.Name,
+				Prints:   s.Prints,
+			}
+		}),
+	})
+}
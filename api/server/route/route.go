@@ -1,22 +1,43 @@
 package route
 
 import (
+	apiKeyUseCase "api/application/apikey"
+	authUseCase "api/application/auth"
+	cacheUseCase "api/application/cache"
 	deckUseCase "api/application/deck"
 	"api/application/detail"
 	"api/application/search"
 	searchDeckUseCase "api/application/search/deck"
+	searchEffectUseCase "api/application/search/effect"
+	suggestUseCase "api/application/search/suggest"
+	"api/application/translation"
+	"api/config"
+	"api/domain/apikey"
+	"api/domain/deck"
+	"api/infrastructure/cache"
+	"api/infrastructure/failover"
 	meiliQueryService "api/infrastructure/meilisearch/query_service"
 	mysqlQueryService "api/infrastructure/mysql/query_service"
 	"api/infrastructure/mysql/repository"
+	suggestIndex "api/infrastructure/suggest"
+	"api/infrastructure/token"
+	"api/pkg/circuitbreaker"
+	"api/pkg/ratelimit"
+	adminPre "api/presentation/admin"
+	apiKeyPre "api/presentation/apikey"
+	authPre "api/presentation/auth"
 	deckPre "api/presentation/deck"
 	detailPre "api/presentation/detail"
+	authMiddleware "api/presentation/middleware"
 	searchPre "api/presentation/search"
+	"context"
+	"log"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
-func InitRoute(e *echo.Echo) {
+func InitRoute(ctx context.Context, e *echo.Echo) {
 	e.Use(middleware.Recover())
 	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 		Format: "time=${time_rfc3339_nano}, method=${method}, uri=${uri}, status=${status}\n",
@@ -24,53 +45,155 @@ func InitRoute(e *echo.Echo) {
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, authMiddleware.ApiKeyHeader},
 	}))
 
+	authConfig := config.GetConfig().Auth
+	auth := authMiddleware.NewAuthMiddleware(authConfig.JWTSecret)
+
+	// APIキーがあればすべてのルートの前に認証とレート制限を行う
+	apiKeyRepository := repository.NewApiKeyRepository()
+	authenticateApiKeyUseCase := apiKeyUseCase.NewAuthenticateApiKeyUseCase(apiKeyRepository)
+	limiter := ratelimit.NewLimiter(authConfig.ApiKeyRateLimit, authConfig.ApiKeyBurst)
+	e.Use(authMiddleware.NewApiKeyMiddleware(authenticateApiKeyUseCase, limiter).GetApiKeyMiddleware())
+
+	cardRepository, cardCache := newCardRepository(config.GetConfig().Cache)
+	suggestUseCase := newSuggestUseCase(ctx)
+	translateUseCase := translation.NewTranslateUseCase(mysqlQueryService.NewTranslationQueryService())
+
 	v1 := e.Group("/v1")
 
-	cardSearchRoute(v1)
-	cardDetailRoute(v1)
-	deckRoute(v1)
+	authRoute(v1, authConfig)
+	apiKeyRoute(v1, auth)
+	adminRoute(v1, config.GetConfig().Admin, cardCache, suggestUseCase)
+	cardSearchRoute(ctx, v1, config.GetConfig().Search, suggestUseCase, translateUseCase)
+	cardDetailRoute(v1, translateUseCase)
+	deckRoute(v1, auth, cardRepository, translateUseCase)
+}
+
+// newCardRepository カード情報はインポートのとき以外変わらないので、設定されていればメモリにキャッシュする
+// キャッシュしない場合、2つめの戻り値はnil
+func newCardRepository(cacheConfig config.CacheConfig) (deck.CardRepository, cacheUseCase.CardCache) {
+	cardRepository := repository.NewCardRepository()
+	if cacheConfig.CardCacheSize <= 0 {
+		return cardRepository, nil
+	}
+	cached := cache.NewCardRepository(cardRepository, cacheConfig.CardCacheSize)
+	return cached, cached
+}
+
+// newSuggestUseCase 起動時にカード名の索引を作る。作れなくても起動し、管理APIから作り直せる
+func newSuggestUseCase(ctx context.Context) *suggestUseCase.SuggestUseCase {
+	uc := suggestUseCase.NewSuggestUseCase(mysqlQueryService.NewCardNameQueryService(), suggestIndex.NewCardNameIndex())
+	res, err := uc.Refresh(ctx)
+	if err != nil {
+		log.Printf("failed to build card name suggest index: %v", err)
+		return uc
+	}
+	log.Printf("built card name suggest index: %d names from %d cards", res.Names, res.Cards)
+	return uc
+}
+
+func authRoute(g *echo.Group, authConfig config.AuthConfig) {
+	userRepository := repository.NewUserRepository()
+	tokenIssuer := token.NewJWTTokenIssuer(authConfig.JWTSecret, authConfig.TokenTTL)
+	registerUseCase := authUseCase.NewRegisterUseCase(userRepository, tokenIssuer)
+	loginUseCase := authUseCase.NewLoginUseCase(userRepository, tokenIssuer)
+	h := authPre.NewAuthHandler(registerUseCase, loginUseCase)
+
+	group := g.Group("/auth")
+	group.POST("/register", h.Register)
+	group.POST("/login", h.Login)
+}
+
+func apiKeyRoute(g *echo.Group, auth *authMiddleware.AuthMiddleware) {
+	apiKeyRepository := repository.NewApiKeyRepository()
+	h := apiKeyPre.NewApiKeyHandler(apiKeyUseCase.NewApiKeyUseCase(apiKeyRepository))
+
+	group := g.Group("/api-keys", auth.GetAuthMiddleware())
+	group.POST("", h.IssueApiKey)
+	group.GET("", h.GetApiKeys)
+	group.DELETE("/:id", h.RevokeApiKey)
+}
+
+func adminRoute(g *echo.Group, adminConfig config.AdminConfig, cardCache cacheUseCase.CardCache, suggestUseCase *suggestUseCase.SuggestUseCase) {
+	h := adminPre.NewAdminHandler(cacheUseCase.NewCardCacheUseCase(cardCache), suggestUseCase)
+
+	group := g.Group("/admin", authMiddleware.RequireAdminToken(adminConfig.Token))
+	group.GET("/cache/cards", h.GetCardCacheStats)
+	group.DELETE("/cache/cards", h.InvalidateCardCache)
+	group.POST("/suggest/refresh", h.RefreshSuggestIndex)
 }
 
-func cardSearchRoute(g *echo.Group) {
-	// Meilisearchの実装に切り替え
-	pokemonRepository := meiliQueryService.NewPokemonQueryService()
-	trainerRepository := meiliQueryService.NewTrainerQueryService()
-	energyRepository := meiliQueryService.NewEnergyQueryService()
+func cardSearchRoute(ctx context.Context, g *echo.Group, searchConfig config.SearchConfig, suggestUseCase *suggestUseCase.SuggestUseCase, translateUseCase *translation.TranslateUseCase) {
+	// Meilisearchが使えないときはMySQLで検索する
+	breaker := circuitbreaker.New(searchConfig.BreakerThreshold, searchConfig.BreakerCooldown)
+	failover.StartHealthProbe(ctx, breaker, searchConfig.HealthInterval, meiliQueryService.Healthy)
+	pokemonRepository := failover.NewPokemonQueryService(
+		meiliQueryService.NewPokemonQueryService(),
+		mysqlQueryService.NewPokemonSearchQueryService(),
+		breaker,
+	)
+	trainerRepository := failover.NewTrainerQueryService(
+		meiliQueryService.NewTrainerQueryService(),
+		mysqlQueryService.NewTrainerSearchQueryService(),
+		breaker,
+	)
+	energyRepository := failover.NewEnergyQueryService(
+		meiliQueryService.NewEnergyQueryService(),
+		mysqlQueryService.NewEnergySearchQueryService(),
+		breaker,
+	)
 	searchRepository := search.NewSearchPokemonAndTrainerUseCase(
 		pokemonRepository,
 		trainerRepository,
 		energyRepository,
 	)
+	// 種類をまたいだ検索は1回のリクエストで行い、ランキングスコアの順に並べる
+	searchRankedCardUseCase := search.NewSearchRankedCardUseCase(
+		failover.NewRankedCardQueryService(meiliQueryService.NewRankedCardQueryService(), breaker),
+	)
 	deckQueryService := meiliQueryService.NewDeckQueryService()
 	searchDeckUseCase := searchDeckUseCase.NewSearchDeckUseCase(deckQueryService)
-	h := searchPre.NewSearchHandler(searchRepository, searchDeckUseCase)
+	// ワザと特性の本文検索はMeilisearchだけで行う
+	searchEffectUseCase := searchEffectUseCase.NewSearchEffectUseCase(meiliQueryService.NewEffectQueryService())
+	h := searchPre.NewSearchHandler(searchRepository, searchRankedCardUseCase, searchDeckUseCase, searchEffectUseCase, translateUseCase)
 
-	group := g.Group("/search")
+	group := g.Group("/search", authMiddleware.RequireScope(apikey.ScopeSearchRead), authMiddleware.NegotiateLang())
 	group.GET("/cards", h.SearchCardList)
+	group.GET("/cards/ranked", h.SearchRankedCardList)
 	group.GET("/decks", h.SearchDeckList)
+	group.GET("/effects", h.SearchEffectList)
+	group.GET("/suggest", searchPre.NewSuggestHandler(suggestUseCase).Suggest)
 }
 
-func cardDetailRoute(g *echo.Group) {
+func cardDetailRoute(g *echo.Group, translateUseCase *translation.TranslateUseCase) {
 	detailRepository := mysqlQueryService.NewDetailQueryService()
 	detailUseCase := detail.NewFetchDetailUseCase(detailRepository)
-	h := detailPre.NewDetailHandler(detailUseCase)
+	h := detailPre.NewDetailHandler(detailUseCase, translateUseCase)
 
-	group := g.Group("/cards")
+	group := g.Group("/cards", authMiddleware.NegotiateLang())
 	group.GET("/detail/:card_type/:id", h.FetchDetail)
 }
 
-func deckRoute(g *echo.Group) {
-	deckRepository := repository.NewDeckRepository()
-	cardRepository := repository.NewCardRepository()
+func deckRoute(g *echo.Group, auth *authMiddleware.AuthMiddleware, cardRepository deck.CardRepository, translateUseCase *translation.TranslateUseCase) {
+	// デッキの読み込みでも、キャッシュするカードリポジトリからカード情報を取得する
+	deckRepository := repository.NewDeckRepository(cardRepository)
+	deckVersionRepository := repository.NewDeckVersionRepository(cardRepository)
+	cardLookupQueryService := mysqlQueryService.NewCardLookupQueryService()
 
 	listDeckUseCase := deckUseCase.NewListDeckUseCase(deckRepository)
 	createDeckUseCase := deckUseCase.NewCreateDeckUseCase(deckRepository, cardRepository)
 	validateDeckUseCase := deckUseCase.NewValidateDeckUseCase(cardRepository)
 	updateDeckUseCase := deckUseCase.NewUpdateDeckUseCase(deckRepository, cardRepository)
 	deleteDeckUseCase := deckUseCase.NewDeleteDeckUseCase(deckRepository)
+	deckProbabilityUseCase := deckUseCase.NewDeckProbabilityUseCase(deckRepository)
+	simulateDeckUseCase := deckUseCase.NewSimulateDeckUseCase(deckRepository)
+	deckVersionUseCase := deckUseCase.NewDeckVersionUseCase(deckRepository, deckVersionRepository)
+	revertDeckUseCase := deckUseCase.NewRevertDeckUseCase(deckRepository, deckVersionRepository)
+	importDeckUseCase := deckUseCase.NewImportDeckUseCase(cardLookupQueryService, createDeckUseCase)
+	exportDeckUseCase := deckUseCase.NewExportDeckUseCase(deckRepository, cardLookupQueryService)
+	deckCodeUseCase := deckUseCase.NewDeckCodeUseCase(deckRepository, createDeckUseCase)
 
 	deckHandler := deckPre.NewDeckHandler(
 		listDeckUseCase,
@@ -78,13 +201,36 @@ func deckRoute(g *echo.Group) {
 		validateDeckUseCase,
 		updateDeckUseCase,
 		deleteDeckUseCase,
+		translateUseCase,
 	)
 
-	group := g.Group("/decks")
-	group.GET("", deckHandler.GetAllDecks)
-	group.GET("/detail/:id", deckHandler.GetDeckById)
-	group.POST("/create", deckHandler.CreateDeck)
+	deckAnalysisHandler := deckPre.NewDeckAnalysisHandler(deckProbabilityUseCase, simulateDeckUseCase)
+	deckVersionHandler := deckPre.NewDeckVersionHandler(deckVersionUseCase, revertDeckUseCase)
+	deckTextHandler := deckPre.NewDeckTextHandler(importDeckUseCase, exportDeckUseCase)
+	deckCodeHandler := deckPre.NewDeckCodeHandler(deckCodeUseCase)
+
+	// 閲覧はログインなしでもできる。作成・編集・削除はログインしたユーザーだけ
+	// APIキーの場合はスコープも確認し、保存したデッキを読むAPIにはdeck:readが必要
+	// 保存したデッキを読まない検証APIはスコープを問わない
+	requireAuth := auth.GetAuthMiddleware()
+	canRead := authMiddleware.RequireScope(apikey.ScopeDeckRead)
+	canWrite := authMiddleware.RequireScope(apikey.ScopeDeckWrite)
+
+	group := g.Group("/decks", authMiddleware.NegotiateLang())
+	group.GET("", deckHandler.GetAllDecks, requireAuth, canRead)
+	group.GET("/detail/:id", deckHandler.GetDeckById, canRead)
+	group.POST("/create", deckHandler.CreateDeck, requireAuth, canWrite)
 	group.POST("/validate", deckHandler.ValidateDeck)
-	group.POST("/edit/:id", deckHandler.UpdateDeck)
-	group.DELETE("/delete/:id", deckHandler.DeleteDeck)
+	group.POST("/import", deckTextHandler.ImportDeck, requireAuth, canWrite)
+	group.POST("/code", deckCodeHandler.CreateDeckFromCode, requireAuth, canWrite)
+	group.POST("/edit/:id", deckHandler.UpdateDeck, requireAuth, canWrite)
+	group.DELETE("/delete/:id", deckHandler.DeleteDeck, requireAuth, canWrite)
+	group.GET("/:id/probabilities", deckAnalysisHandler.GetProbabilities, canRead)
+	group.POST("/:id/simulate", deckAnalysisHandler.Simulate, canRead)
+	group.GET("/:id/versions", deckVersionHandler.GetVersions, canRead)
+	group.GET("/:id/versions/diff", deckVersionHandler.DiffVersions, canRead)
+	group.GET("/:id/versions/:version", deckVersionHandler.GetVersion, canRead)
+	group.POST("/:id/versions/:version/revert", deckVersionHandler.RevertDeck, requireAuth, canWrite)
+	group.GET("/:id/export", deckTextHandler.ExportDeck, canRead)
+	group.GET("/:id/code", deckCodeHandler.GetDeckCode, canRead)
 }
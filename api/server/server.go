@@ -9,7 +9,7 @@ import (
 
 func Run(ctx context.Context) error {
 	e := echo.New()
-	route.InitRoute(e)
+	route.InitRoute(ctx, e)
 
 	return e.Start(":8080")
 }
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Translation is one row of a translations CSV: kind,source,lang,text
+type Translation struct {
+	Kind   string
+	Source string
+	Lang   string
+	Text   string
+}
+
+// Must match the kinds the API looks up in the translations table
+var translationKinds = map[string]bool{
+	"card_name":   true,
+	"effect_name": true,
+	"label":       true,
+}
+
+var importTranslationsCmd = &cobra.Command{
+	Use:   "import-translations [csv files]",
+	Short: "import card name translations to MySQL",
+	Long: `import translations of card names, attack and ability names and type labels to MySQL.
+Each CSV has the header kind,source,lang,text, where source is the Japanese text.
+Existing translations are overwritten. Run index-card afterwards so English queries find the cards.`,
+	Args: cobra.MinimumNArgs(1),
+	// Bind here rather than in init, where every command binds the same keys and the last one wins
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("mysql.host", cmd.Flags().Lookup("mysql-host"))
+		viper.BindPFlag("mysql.port", cmd.Flags().Lookup("mysql-port"))
+		viper.BindPFlag("mysql.user", cmd.Flags().Lookup("mysql-user"))
+		viper.BindPFlag("mysql.password", cmd.Flags().Lookup("mysql-password"))
+		viper.BindPFlag("mysql.dbname", cmd.Flags().Lookup("mysql-dbname"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		db, err := connectDB(MySQLConfig{
+			User:     viper.GetString("mysql.user"),
+			Password: viper.GetString("mysql.password"),
+			Host:     viper.GetString("mysql.host"),
+			Port:     viper.GetString("mysql.port"),
+			DBName:   viper.GetString("mysql.dbname"),
+		})
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		defer db.Close()
+
+		for _, path := range args {
+			translations, err := readTranslations(path)
+			if err != nil {
+				log.Fatalf("Failed to read %s: %v", path, err)
+			}
+			if err := ImportTranslations(db, translations); err != nil {
+				log.Fatalf("Failed to import %s: %v", path, err)
+			}
+			fmt.Printf("Imported %d translations from %s\n", len(translations), path)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importTranslationsCmd)
+
+	importTranslationsCmd.Flags().String("mysql-host", "localhost", "MySQL host")
+	importTranslationsCmd.Flags().String("mysql-port", "3306", "MySQL port")
+	importTranslationsCmd.Flags().String("mysql-user", "root", "MySQL user")
+	importTranslationsCmd.Flags().String("mysql-password", "pass", "MySQL password")
+	importTranslationsCmd.Flags().String("mysql-dbname", "ptcgmcpdb", "MySQL database name")
+}
+
+func readTranslations(path string) ([]Translation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 4
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	if header[0] != "kind" || header[1] != "source" || header[2] != "lang" || header[3] != "text" {
+		return nil, errors.New("header must be kind,source,lang,text")
+	}
+
+	var translations []Translation
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		t := Translation{Kind: record[0], Source: record[1], Lang: record[2], Text: record[3]}
+		line, _ := r.FieldPos(0)
+		if !translationKinds[t.Kind] {
+			return nil, fmt.Errorf("line %d: unknown kind %q", line, t.Kind)
+		}
+		// Cards are stored in Japanese, so a ja translation would never be looked up
+		if t.Lang == "" || t.Lang == "ja" {
+			return nil, fmt.Errorf("line %d: lang must be a language other than ja", line)
+		}
+		if t.Source == "" || t.Text == "" {
+			return nil, fmt.Errorf("line %d: source and text must not be empty", line)
+		}
+		translations = append(translations, t)
+	}
+	return translations, nil
+}
+
+func ImportTranslations(db *sql.DB, translations []Translation) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`INSERT INTO translations (kind, source, lang, text) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE text = VALUES(text)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, t := range translations {
+		if _, err := stmt.Exec(t.Kind, t.Source, t.Lang, t.Text); err != nil {
+			return fmt.Errorf("importing %s %q: %w", t.Kind, t.Source, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// translations maps kind -> Japanese source -> translated text
+type translations map[string]map[string]string
+
+// text is empty when the source has no translation
+func (t translations) text(kind, source string) string {
+	return t[kind][source]
+}
+
+func loadTranslations(db *sql.DB, lang string) translations {
+	rows, err := db.Query(`SELECT kind, source, text FROM translations WHERE lang = ?`, lang)
+	if err != nil {
+		log.Fatalf("Failed to query translations: %v", err)
+	}
+	defer rows.Close()
+
+	loaded := make(translations)
+	for rows.Next() {
+		var kind, source, text string
+		if err := rows.Scan(&kind, &source, &text); err != nil {
+			log.Printf("Error scanning translation row: %v", err)
+			continue
+		}
+		if loaded[kind] == nil {
+			loaded[kind] = make(map[string]string)
+		}
+		loaded[kind][source] = text
+	}
+	return loaded
+}
@@ -4,9 +4,12 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"api/pkg/normalize"
 	"database/sql"
 	"fmt"
 	"log"
+	"strconv"
+	"unicode/utf8"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/meilisearch/meilisearch-go"
@@ -32,47 +35,96 @@ type MeilisearchConfig struct {
 type Pokemon struct {
 	ID                 int64    `json:"id"`
 	Name               string   `json:"name"`
+	NormalizedName     string   `json:"normalized_name"`
+	NameEn             string   `json:"name_en,omitempty"`
+	NormalizedNameEn   string   `json:"normalized_name_en,omitempty"`
 	EnergyType         string   `json:"energy_type"`
 	ImageURL           string   `json:"image_url"`
 	HP                 int64    `json:"hp"`
 	Ability            string   `json:"ability,omitempty"`
 	AbilityDescription string   `json:"ability_description,omitempty"`
+	HasAbility         bool     `json:"has_ability"`
 	Regulation         string   `json:"regulation"`
 	Expansion          string   `json:"expansion"`
+	Stage              string   `json:"stage,omitempty"`
+	EvolvesFrom        string   `json:"evolves_from,omitempty"`
+	AceSpec            bool     `json:"ace_spec"`
+	Radiant            bool     `json:"radiant"`
+	PrismStar          bool     `json:"prism_star"`
+	RuleBox            bool     `json:"rule_box"`
 	Attacks            []Attack `json:"attacks,omitempty"`
 }
 
 type Attack struct {
+	ID             int64  `json:"id"`
 	Name           string `json:"name"`
 	RequiredEnergy string `json:"required_energy"`
 	Damage         string `json:"damage,omitempty"`
 	Description    string `json:"description,omitempty"`
 }
 
+// PokemonEffect is one attack or ability in the pokemon_effects index, so that
+// text search and the damage/energy filters apply to a single attack rather than the whole card.
+type PokemonEffect struct {
+	ID             string `json:"id"`
+	PokemonID      int64  `json:"pokemon_id"`
+	PokemonName    string `json:"pokemon_name"`
+	PokemonNameEn  string `json:"pokemon_name_en,omitempty"`
+	EnergyType     string `json:"energy_type"`
+	ImageURL       string `json:"image_url"`
+	Regulation     string `json:"regulation"`
+	Kind           string `json:"kind"`
+	Name           string `json:"name"`
+	RequiredEnergy string `json:"required_energy,omitempty"`
+	EnergyCount    int    `json:"energy_count"`
+	Damage         string `json:"damage,omitempty"`
+	DamageValue    int    `json:"damage_value,omitempty"`
+	Description    string `json:"description,omitempty"`
+	// Searched by the normalized query while name and description stay for highlighting
+	NormalizedName        string `json:"normalized_name"`
+	NormalizedDescription string `json:"normalized_description,omitempty"`
+	NameEn                string `json:"name_en,omitempty"`
+	NormalizedNameEn      string `json:"normalized_name_en,omitempty"`
+}
+
 type Trainer struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	TrainerType string `json:"trainer_type"`
-	ImageURL    string `json:"image_url"`
-	Description string `json:"description"`
-	Regulation  string `json:"regulation"`
-	Expansion   string `json:"expansion"`
+	ID                    int64  `json:"id"`
+	Name                  string `json:"name"`
+	NormalizedName        string `json:"normalized_name"`
+	NameEn                string `json:"name_en,omitempty"`
+	NormalizedNameEn      string `json:"normalized_name_en,omitempty"`
+	TrainerType           string `json:"trainer_type"`
+	ImageURL              string `json:"image_url"`
+	Description           string `json:"description"`
+	NormalizedDescription string `json:"normalized_description"`
+	Regulation            string `json:"regulation"`
+	Expansion             string `json:"expansion"`
+	AceSpec               bool   `json:"ace_spec"`
+	PrismStar             bool   `json:"prism_star"`
 }
 
 type Energy struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	ImageURL    string `json:"image_url"`
-	Description string `json:"description"`
-	Regulation  string `json:"regulation"`
-	Expansion   string `json:"expansion"`
+	ID                    int64  `json:"id"`
+	Name                  string `json:"name"`
+	NormalizedName        string `json:"normalized_name"`
+	NameEn                string `json:"name_en,omitempty"`
+	NormalizedNameEn      string `json:"normalized_name_en,omitempty"`
+	ImageURL              string `json:"image_url"`
+	Description           string `json:"description"`
+	NormalizedDescription string `json:"normalized_description"`
+	Regulation            string `json:"regulation"`
+	Expansion             string `json:"expansion"`
+	AceSpec               bool   `json:"ace_spec"`
+	PrismStar             bool   `json:"prism_star"`
 }
 
 // indexCardCmd represents the indexCard command
 var indexCardCmd = &cobra.Command{
 	Use:   "index-card",
 	Short: "index card to Meilisearch",
-	Long:  `index pokemons/trainers/energies to Meilisearch.`,
+	Long: `index pokemons/trainers/energies to Meilisearch.
+Only cards changed since the previous run are indexed, and cards deleted from MySQL are removed.
+Use --full to re-index every card.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		fmt.Println("Indexing cards to Meilisearch...")
 
@@ -118,7 +170,8 @@ var indexCardCmd = &cobra.Command{
 		}
 		defer db.Close()
 
-		IndexCard(db, meiliConfig)
+		full, _ := cmd.Flags().GetBool("full")
+		IndexCard(db, meiliConfig, full)
 	},
 }
 
@@ -134,6 +187,7 @@ func init() {
 
 	indexCardCmd.Flags().String("meilisearch-host", "http://localhost:7700", "Meilisearch host")
 	indexCardCmd.Flags().String("meilisearch-key", "DevelopmentMasterKey", "Meilisearch API key")
+	indexCardCmd.Flags().Bool("full", false, "Re-index every card instead of only the cards changed since the last run")
 
 	// Bind flags with viper
 	viper.BindPFlag("mysql.host", indexCardCmd.Flags().Lookup("mysql-host"))
@@ -162,26 +216,56 @@ func connectDB(config MySQLConfig) (*sql.DB, error) {
 	return db, nil
 }
 
-func IndexCard(db *sql.DB, config MeilisearchConfig) {
+func IndexCard(db *sql.DB, config MeilisearchConfig, full bool) {
 	client := meilisearch.New(config.Host, meilisearch.WithAPIKey(config.Key))
 
 	fmt.Println("Connected to Meilisearch at", config.Host)
 
-	IndexPokemon(client, db)
-	IndexTrainer(client, db)
-	IndexEnergy(client, db)
+	// English names are indexed next to the Japanese ones so that English queries find the cards
+	en := loadTranslations(db, "en")
+	IndexPokemon(client, db, en, full)
+	IndexTrainer(client, db, en, full)
+	IndexEnergy(client, db, en, full)
 
 	fmt.Println("Indexing complete!")
 }
 
-func IndexPokemon(client meilisearch.ServiceManager, db *sql.DB) {
-	fmt.Println("Indexing Pokémon cards...")
-	index := client.Index("pokemons")
+// maxTotalHits is how far the API can page into a card index.
+// Meilisearch stops at 1000 hits by default, which common queries like a single energy type exceed.
+const maxTotalHits = 20000
 
-	// Get all Pokémon cards from database
+func updatePagination(index meilisearch.IndexManager) {
+	_, err := index.UpdatePagination(&meilisearch.Pagination{MaxTotalHits: maxTotalHits})
+	if err != nil {
+		log.Fatalf("Failed to update pagination settings: %v", err)
+	}
+}
+
+// changedPokemonCondition also matches Pokémon whose attacks or translations changed,
+// since both are part of the Pokémon and effect documents. Deleted attacks and translations
+// are found by staleEffectPokemonIDs and staleTranslationIDs
+const changedPokemonCondition = `? IS NULL OR updated_at >= ?
+	OR id IN (SELECT pokemon_id FROM pokemon_attacks WHERE updated_at >= ?)
+	OR name IN (SELECT source FROM translations WHERE updated_at >= ?)
+	OR ability IN (SELECT source FROM translations WHERE updated_at >= ?)
+	OR id IN (SELECT a.pokemon_id FROM pokemon_attacks a JOIN translations t ON t.source = a.name WHERE t.updated_at >= ?)`
+
+func IndexPokemon(client meilisearch.ServiceManager, db *sql.DB, en translations, full bool) {
+	index := client.Index("pokemons")
+	indexedUntil := dbNow(db)
+	since := loadWatermark(db, "pokemons", full)
+	fmt.Printf("Indexing Pokémon cards (%s)...\n", describeWatermark(since))
+
+	condition, args := changedPokemonCondition, sinceArgs(since, 6)
+	if since != nil {
+		stale := append(staleTranslationIDs(index, en), staleEffectPokemonIDs(client.Index("pokemon_effects"), db, en)...)
+		condition, args = orIDs(condition, args, stale)
+	}
 	rows, err := db.Query(`SELECT id, name, energy_type, image_url, hp, 
-		ability, ability_description, regulation, expansion 
-		FROM pokemons`)
+		ability, ability_description, regulation, expansion, stage, evolves_from,
+		ace_spec, radiant, prism_star, rule_box
+		FROM pokemons
+		WHERE `+condition, args...)
 	if err != nil {
 		log.Fatalf("Failed to query Pokémon data: %v", err)
 	}
@@ -190,10 +274,11 @@ func IndexPokemon(client meilisearch.ServiceManager, db *sql.DB) {
 	var pokemons []Pokemon
 	for rows.Next() {
 		var p Pokemon
-		var ability, abilityDesc sql.NullString
+		var ability, abilityDesc, stage, evolvesFrom sql.NullString
 
 		err := rows.Scan(&p.ID, &p.Name, &p.EnergyType, &p.ImageURL, &p.HP,
-			&ability, &abilityDesc, &p.Regulation, &p.Expansion)
+			&ability, &abilityDesc, &p.Regulation, &p.Expansion, &stage, &evolvesFrom,
+			&p.AceSpec, &p.Radiant, &p.PrismStar, &p.RuleBox)
 		if err != nil {
 			log.Printf("Error scanning Pokémon row: %v", err)
 			continue
@@ -202,37 +287,174 @@ func IndexPokemon(client meilisearch.ServiceManager, db *sql.DB) {
 		if ability.Valid {
 			p.Ability = ability.String
 		}
+		p.HasAbility = p.Ability != ""
 		if abilityDesc.Valid {
 			p.AbilityDescription = abilityDesc.String
 		}
+		// Cards whose stage has not been registered leave it empty
+		p.Stage = stage.String
+		if evolvesFrom.Valid {
+			p.EvolvesFrom = evolvesFrom.String
+		}
+		p.NormalizedName = normalize.String(p.Name)
+		p.NameEn = en.text("card_name", p.Name)
+		p.NormalizedNameEn = normalize.Latin(p.NameEn)
 
 		// Get attacks for this Pokémon
 		p.Attacks = getPokemonAttacks(db, p.ID)
 		pokemons = append(pokemons, p)
 	}
 
-	if len(pokemons) == 0 {
-		fmt.Println("No Pokémon found in database")
-		return
+	if len(pokemons) > 0 {
+		task, err := index.AddDocuments(pokemons)
+		waitForTask(client, task, err, "index Pokémon data")
+	}
+
+	sortableAttributes := []string{"id", "name", "hp"}
+	_, err = index.UpdateSortableAttributes(&sortableAttributes)
+	if err != nil {
+		log.Fatalf("Failed to update sortable attributes: %v", err)
 	}
+	updatePagination(index)
 
-	// Index Pokémon cards
-	_, err = index.AddDocuments(pokemons)
+	// Must match the filters and facets requested by the API search query services
+	filterableAttributes := []string{"energy_type", "hp", "regulation", "expansion", "ace_spec", "has_ability"}
+	_, err = index.UpdateFilterableAttributes(&filterableAttributes)
 	if err != nil {
-		log.Fatalf("Failed to index Pokémon data: %v", err)
+		log.Fatalf("Failed to update filterable attributes: %v", err)
+	}
+
+	fmt.Printf("Successfully indexed %d Pokémon cards\n", len(pokemons))
+	deleted := deleteMissingCards(client, index, db, "pokemons")
+
+	IndexPokemonEffect(client, pokemons, deleted, en, since == nil)
+	// The effects are built from the same rows, so they share the Pokémon watermark
+	saveWatermark(db, "pokemons", indexedUntil)
+}
+
+// IndexPokemonEffect replaces the attacks and abilities of the given Pokémon.
+// With all set, pokemons is every Pokémon and any other effect is removed.
+func IndexPokemonEffect(client meilisearch.ServiceManager, pokemons []Pokemon, deletedPokemonIDs []string, en translations, all bool) {
+	fmt.Println("Indexing Pokémon attacks and abilities...")
+	index := client.Index("pokemon_effects")
+
+	// pokemon_id must be filterable before the stale effects can be looked up by it
+	filterableAttributes := []string{"pokemon_id", "kind", "damage_value", "energy_count", "regulation"}
+	task, err := index.UpdateFilterableAttributes(&filterableAttributes)
+	waitForTask(client, task, err, "update filterable attributes")
+
+	var effects []PokemonEffect
+	for _, p := range pokemons {
+		base := PokemonEffect{
+			PokemonID:     p.ID,
+			PokemonName:   p.Name,
+			PokemonNameEn: p.NameEn,
+			EnergyType:    p.EnergyType,
+			ImageURL:      p.ImageURL,
+			Regulation:    p.Regulation,
+		}
+		if p.HasAbility {
+			e := base
+			e.ID = fmt.Sprintf("ability-%d", p.ID)
+			e.Kind = "ability"
+			e.Name = p.Ability
+			e.Description = p.AbilityDescription
+			e.NormalizedName = normalize.String(e.Name)
+			e.NormalizedDescription = normalize.String(e.Description)
+			e.NameEn = en.text("effect_name", e.Name)
+			e.NormalizedNameEn = normalize.Latin(e.NameEn)
+			effects = append(effects, e)
+		}
+		for _, a := range p.Attacks {
+			e := base
+			e.ID = fmt.Sprintf("attack-%d", a.ID)
+			e.Kind = "attack"
+			e.Name = a.Name
+			e.RequiredEnergy = a.RequiredEnergy
+			e.EnergyCount = utf8.RuneCountInString(a.RequiredEnergy)
+			e.Damage = a.Damage
+			e.DamageValue = damageValue(a.Damage)
+			e.Description = a.Description
+			e.NormalizedName = normalize.String(e.Name)
+			e.NormalizedDescription = normalize.String(e.Description)
+			e.NameEn = en.text("effect_name", e.Name)
+			e.NormalizedNameEn = normalize.Latin(e.NameEn)
+			effects = append(effects, e)
+		}
+	}
+
+	if len(effects) > 0 {
+		task, err := index.AddDocuments(effects)
+		waitForTask(client, task, err, "index Pokémon effect data")
 	}
 
-	sortableAttributes := []string{"id"}
+	// Attacks removed from a Pokémon, and the effects of deleted Pokémon
+	indexed := make(map[string]bool, len(effects))
+	for _, e := range effects {
+		indexed[e.ID] = true
+	}
+	var existing []string
+	if all {
+		existing = documentIDs(index, "")
+	} else {
+		pokemonIDs := deletedPokemonIDs
+		for _, p := range pokemons {
+			pokemonIDs = append(pokemonIDs, strconv.FormatInt(p.ID, 10))
+		}
+		for _, filter := range inFilter("pokemon_id", pokemonIDs) {
+			existing = append(existing, documentIDs(index, filter)...)
+		}
+	}
+	var stale []string
+	for _, id := range existing {
+		if !indexed[id] {
+			stale = append(stale, id)
+		}
+	}
+	if len(stale) > 0 {
+		task, err := index.DeleteDocuments(stale)
+		waitForTask(client, task, err, "delete removed attacks and abilities")
+	}
+
+	// Only the text is searched; damage is matched through damage_value filters
+	searchableAttributes := []string{"name", "normalized_name", "name_en", "normalized_name_en", "description", "normalized_description", "damage"}
+	_, err = index.UpdateSearchableAttributes(&searchableAttributes)
+	if err != nil {
+		log.Fatalf("Failed to update searchable attributes: %v", err)
+	}
+
+	sortableAttributes := []string{"pokemon_id", "name"}
 	_, err = index.UpdateSortableAttributes(&sortableAttributes)
 	if err != nil {
 		log.Fatalf("Failed to update sortable attributes: %v", err)
 	}
+	updatePagination(index)
 
-	fmt.Printf("Successfully indexed %d Pokémon cards\n", len(pokemons))
+	fmt.Printf("Successfully indexed %d attacks and abilities and deleted %d\n", len(effects), len(stale))
+}
+
+// damageValue reads the base damage from texts like "120", "30+" or "50×".
+// Attacks without a number (no damage, or damage set by the effect) count as 0.
+func damageValue(damage string) int {
+	var digits []rune
+	for _, r := range damage {
+		if r >= '０' && r <= '９' {
+			r = '0' + (r - '０')
+		}
+		if r < '0' || r > '9' {
+			if len(digits) > 0 {
+				break
+			}
+			continue
+		}
+		digits = append(digits, r)
+	}
+	v, _ := strconv.Atoi(string(digits))
+	return v
 }
 
 func getPokemonAttacks(db *sql.DB, pokemonID int64) []Attack {
-	rows, err := db.Query(`SELECT name, required_energy, damage, description 
+	rows, err := db.Query(`SELECT id, name, required_energy, damage, description 
 		FROM pokemon_attacks 
 		WHERE pokemon_id = ?`, pokemonID)
 	if err != nil {
@@ -246,7 +468,7 @@ func getPokemonAttacks(db *sql.DB, pokemonID int64) []Attack {
 		var a Attack
 		var damage, desc sql.NullString
 
-		err := rows.Scan(&a.Name, &a.RequiredEnergy, &damage, &desc)
+		err := rows.Scan(&a.ID, &a.Name, &a.RequiredEnergy, &damage, &desc)
 		if err != nil {
 			log.Printf("Error scanning attack row: %v", err)
 			continue
@@ -265,13 +487,25 @@ func getPokemonAttacks(db *sql.DB, pokemonID int64) []Attack {
 	return attacks
 }
 
-func IndexTrainer(client meilisearch.ServiceManager, db *sql.DB) {
-	fmt.Println("Indexing Trainer cards...")
+// changedCardCondition matches Trainers and Energies updated since the watermark or whose name translation changed.
+// Deleted translations are found by staleTranslationIDs
+const changedCardCondition = `? IS NULL OR updated_at >= ?
+	OR name IN (SELECT source FROM translations WHERE updated_at >= ?)`
+
+func IndexTrainer(client meilisearch.ServiceManager, db *sql.DB, en translations, full bool) {
 	index := client.Index("trainers")
+	indexedUntil := dbNow(db)
+	since := loadWatermark(db, "trainers", full)
+	fmt.Printf("Indexing Trainer cards (%s)...\n", describeWatermark(since))
 
-	// Get all Trainer cards from database
-	rows, err := db.Query(`SELECT id, name, trainer_type, image_url, description, regulation, expansion 
-		FROM trainers`)
+	condition, args := changedCardCondition, sinceArgs(since, 3)
+	if since != nil {
+		condition, args = orIDs(condition, args, staleTranslationIDs(index, en))
+	}
+	rows, err := db.Query(`SELECT id, name, trainer_type, image_url, description, regulation, expansion,
+		ace_spec, prism_star
+		FROM trainers
+		WHERE `+condition, args...)
 	if err != nil {
 		log.Fatalf("Failed to query Trainer data: %v", err)
 	}
@@ -280,42 +514,57 @@ func IndexTrainer(client meilisearch.ServiceManager, db *sql.DB) {
 	var trainers []Trainer
 	for rows.Next() {
 		var t Trainer
-		err := rows.Scan(&t.ID, &t.Name, &t.TrainerType, &t.ImageURL, &t.Description, &t.Regulation, &t.Expansion)
+		err := rows.Scan(&t.ID, &t.Name, &t.TrainerType, &t.ImageURL, &t.Description, &t.Regulation, &t.Expansion,
+			&t.AceSpec, &t.PrismStar)
 		if err != nil {
 			log.Printf("Error scanning Trainer row: %v", err)
 			continue
 		}
+		t.NormalizedName = normalize.String(t.Name)
+		t.NormalizedDescription = normalize.String(t.Description)
+		t.NameEn = en.text("card_name", t.Name)
+		t.NormalizedNameEn = normalize.Latin(t.NameEn)
 
 		trainers = append(trainers, t)
 	}
 
-	if len(trainers) == 0 {
-		fmt.Println("No Trainers found in database")
-		return
+	if len(trainers) > 0 {
+		task, err := index.AddDocuments(trainers)
+		waitForTask(client, task, err, "index Trainer data")
 	}
 
-	// Index Trainer cards
-	_, err = index.AddDocuments(trainers)
+	sortableAttributes := []string{"id", "name"}
+	_, err = index.UpdateSortableAttributes(&sortableAttributes)
 	if err != nil {
-		log.Fatalf("Failed to index Trainer data: %v", err)
+		log.Fatalf("Failed to update sortable attributes: %v", err)
 	}
+	updatePagination(index)
 
-	sortableAttributes := []string{"id"}
-	_, err = index.UpdateSortableAttributes(&sortableAttributes)
+	filterableAttributes := []string{"trainer_type", "regulation", "expansion", "ace_spec"}
+	_, err = index.UpdateFilterableAttributes(&filterableAttributes)
 	if err != nil {
-		log.Fatalf("Failed to update sortable attributes: %v", err)
+		log.Fatalf("Failed to update filterable attributes: %v", err)
 	}
 
 	fmt.Printf("Successfully indexed %d Trainer cards\n", len(trainers))
+	deleteMissingCards(client, index, db, "trainers")
+	saveWatermark(db, "trainers", indexedUntil)
 }
 
-func IndexEnergy(client meilisearch.ServiceManager, db *sql.DB) {
-	fmt.Println("Indexing Energy cards...")
+func IndexEnergy(client meilisearch.ServiceManager, db *sql.DB, en translations, full bool) {
 	index := client.Index("energies")
+	indexedUntil := dbNow(db)
+	since := loadWatermark(db, "energies", full)
+	fmt.Printf("Indexing Energy cards (%s)...\n", describeWatermark(since))
 
-	// Get all Energy cards from database
-	rows, err := db.Query(`SELECT id, name, image_url, description, regulation, expansion 
-		FROM energies`)
+	condition, args := changedCardCondition, sinceArgs(since, 3)
+	if since != nil {
+		condition, args = orIDs(condition, args, staleTranslationIDs(index, en))
+	}
+	rows, err := db.Query(`SELECT id, name, image_url, description, regulation, expansion,
+		ace_spec, prism_star
+		FROM energies
+		WHERE `+condition, args...)
 	if err != nil {
 		log.Fatalf("Failed to query Energy data: %v", err)
 	}
@@ -324,31 +573,39 @@ func IndexEnergy(client meilisearch.ServiceManager, db *sql.DB) {
 	var energies []Energy
 	for rows.Next() {
 		var e Energy
-		err := rows.Scan(&e.ID, &e.Name, &e.ImageURL, &e.Description, &e.Regulation, &e.Expansion)
+		err := rows.Scan(&e.ID, &e.Name, &e.ImageURL, &e.Description, &e.Regulation, &e.Expansion,
+			&e.AceSpec, &e.PrismStar)
 		if err != nil {
 			log.Printf("Error scanning Energy row: %v", err)
 			continue
 		}
+		e.NormalizedName = normalize.String(e.Name)
+		e.NormalizedDescription = normalize.String(e.Description)
+		e.NameEn = en.text("card_name", e.Name)
+		e.NormalizedNameEn = normalize.Latin(e.NameEn)
 
 		energies = append(energies, e)
 	}
 
-	if len(energies) == 0 {
-		fmt.Println("No Energy cards found in database")
-		return
+	if len(energies) > 0 {
+		task, err := index.AddDocuments(energies)
+		waitForTask(client, task, err, "index Energy data")
 	}
 
-	// Index Energy cards
-	_, err = index.AddDocuments(energies)
+	sortableAttributes := []string{"id", "name"}
+	_, err = index.UpdateSortableAttributes(&sortableAttributes)
 	if err != nil {
-		log.Fatalf("Failed to index Energy data: %v", err)
+		log.Fatalf("Failed to update sortable attributes: %v", err)
 	}
+	updatePagination(index)
 
-	sortableAttributes := []string{"id"}
-	_, err = index.UpdateSortableAttributes(&sortableAttributes)
+	filterableAttributes := []string{"regulation", "expansion", "ace_spec"}
+	_, err = index.UpdateFilterableAttributes(&filterableAttributes)
 	if err != nil {
-		log.Fatalf("Failed to update sortable attributes: %v", err)
+		log.Fatalf("Failed to update filterable attributes: %v", err)
 	}
 
 	fmt.Printf("Successfully indexed %d Energy cards\n", len(energies))
+	deleteMissingCards(client, index, db, "energies")
+	saveWatermark(db, "energies", indexedUntil)
 }
@@ -4,6 +4,7 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"api/pkg/normalize"
 	"database/sql"
 	"fmt"
 	"log"
@@ -16,29 +17,34 @@ import (
 
 // インデックスするデッキのデータ構造
 type DeckDocument struct {
-	ID          int64      `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description,omitempty"`
-	MainCard    *CardInfo  `json:"main_card,omitempty"`
-	SubCard     *CardInfo  `json:"sub_card,omitempty"`
-	Cards       []DeckCard `json:"cards"`
+	ID                    int64      `json:"id"`
+	Name                  string     `json:"name"`
+	NormalizedName        string     `json:"normalized_name"`
+	Description           string     `json:"description,omitempty"`
+	NormalizedDescription string     `json:"normalized_description,omitempty"`
+	Format                string     `json:"format"`
+	MainCard              *CardInfo  `json:"main_card,omitempty"`
+	SubCard               *CardInfo  `json:"sub_card,omitempty"`
+	Cards                 []DeckCard `json:"cards"`
 }
 
 // カード情報の構造体
 type CardInfo struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	ImageURL string `json:"image_url"`
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	NormalizedName string `json:"normalized_name"`
+	Category       string `json:"category"`
+	ImageURL       string `json:"image_url"`
 }
 
 // デッキカードの構造体
 type DeckCard struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	ImageURL string `json:"image_url"`
-	Quantity int    `json:"quantity"`
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	NormalizedName string `json:"normalized_name"`
+	Category       string `json:"category"`
+	ImageURL       string `json:"image_url"`
+	Quantity       int    `json:"quantity"`
 }
 
 // indexDeckCmd represents the indexDeck command
@@ -134,7 +140,8 @@ func IndexDeck(db *sql.DB, meiliConfig MeilisearchConfig) {
 			d.main_card_id, 
 			d.main_card_type_id,
 			d.sub_card_id, 
-			d.sub_card_type_id
+			d.sub_card_type_id,
+			d.format
 		FROM 
 			decks d
 	`)
@@ -158,6 +165,7 @@ func IndexDeck(db *sql.DB, meiliConfig MeilisearchConfig) {
 			&mainCardTypeID,
 			&subCardID,
 			&subCardTypeID,
+			&deck.Format,
 		)
 		if err != nil {
 			log.Printf("デッキデータ読み取りエラー: %v", err)
@@ -167,6 +175,8 @@ func IndexDeck(db *sql.DB, meiliConfig MeilisearchConfig) {
 		if description.Valid {
 			deck.Description = description.String
 		}
+		deck.NormalizedName = normalize.String(deck.Name)
+		deck.NormalizedDescription = normalize.String(deck.Description)
 
 		// メインカードの情報を取得
 		if mainCardID.Valid && mainCardTypeID.Valid {
@@ -210,12 +220,24 @@ func IndexDeck(db *sql.DB, meiliConfig MeilisearchConfig) {
 	}
 
 	// 検索可能なフィールドを設定
-	searchableAttributes := []string{"name", "description", "main_card.name", "sub_card.name", "cards.name"}
+	// APIは正規化したキーワードで検索するので、正規化した項目も検索対象にする
+	searchableAttributes := []string{
+		"name", "normalized_name", "description", "normalized_description",
+		"main_card.name", "main_card.normalized_name", "sub_card.name", "sub_card.normalized_name",
+		"cards.name", "cards.normalized_name",
+	}
 	_, err = index.UpdateSearchableAttributes(&searchableAttributes)
 	if err != nil {
 		log.Printf("検索可能フィールド設定エラー: %v", err)
 	}
 
+	// フォーマットで絞り込めるようにする
+	filterableAttributes := []string{"format"}
+	_, err = index.UpdateFilterableAttributes(&filterableAttributes)
+	if err != nil {
+		log.Printf("フィルター可能フィールド設定エラー: %v", err)
+	}
+
 	// ソート可能なフィールドを設定
 	sortableAttributes := []string{"id", "name"}
 	_, err = index.UpdateSortableAttributes(&sortableAttributes)
@@ -256,6 +278,7 @@ func getCardInfo(db *sql.DB, cardID int64, cardTypeID int64) (*CardInfo, error)
 	}
 
 	card.Category = category
+	card.NormalizedName = normalize.String(card.Name)
 	return &card, nil
 }
 
@@ -296,11 +319,12 @@ func getDeckCards(db *sql.DB, deckID int64) ([]DeckCard, error) {
 		}
 
 		deckCard := DeckCard{
-			ID:       cardInfo.ID,
-			Name:     cardInfo.Name,
-			Category: cardInfo.Category,
-			ImageURL: cardInfo.ImageURL,
-			Quantity: quantity,
+			ID:             cardInfo.ID,
+			Name:           cardInfo.Name,
+			NormalizedName: cardInfo.NormalizedName,
+			Category:       cardInfo.Category,
+			ImageURL:       cardInfo.ImageURL,
+			Quantity:       quantity,
 		}
 
 		cards = append(cards, deckCard)
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Watermarks are kept in MySQL rather than a local file so that index-card continues
+// from the same point whichever machine runs it.
+
+// loadWatermark returns nil when the index has never been built, or when full is set,
+// which makes the card queries read every row.
+func loadWatermark(db *sql.DB, indexUID string, full bool) *time.Time {
+	if full {
+		return nil
+	}
+	var indexedUntil time.Time
+	err := db.QueryRow(`SELECT indexed_until FROM search_index_watermarks WHERE index_uid = ?`, indexUID).Scan(&indexedUntil)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		log.Fatalf("Failed to read the watermark of %s: %v", indexUID, err)
+	}
+	return &indexedUntil
+}
+
+func saveWatermark(db *sql.DB, indexUID string, indexedUntil time.Time) {
+	_, err := db.Exec(`INSERT INTO search_index_watermarks (index_uid, indexed_until) VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE indexed_until = VALUES(indexed_until)`, indexUID, indexedUntil)
+	if err != nil {
+		log.Fatalf("Failed to save the watermark of %s: %v", indexUID, err)
+	}
+}
+
+// dbNow is read before the cards are queried, so rows updated while indexing are picked up again
+// by the next run. The MySQL clock is used because updated_at is set by MySQL.
+func dbNow(db *sql.DB) time.Time {
+	var now time.Time
+	if err := db.QueryRow(`SELECT NOW()`).Scan(&now); err != nil {
+		log.Fatalf("Failed to read the database time: %v", err)
+	}
+	return now
+}
+
+func describeWatermark(since *time.Time) string {
+	if since == nil {
+		return "all cards"
+	}
+	return "cards changed since " + since.Format(time.DateTime)
+}
+
+// sinceArgs repeats the watermark for each placeholder of a changed-rows condition
+func sinceArgs(since *time.Time, n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = since
+	}
+	return args
+}
+
+// waitForTask makes sure Meilisearch applied a change before the watermark moves past it
+func waitForTask(client meilisearch.ServiceManager, task *meilisearch.TaskInfo, err error, what string) {
+	if err != nil {
+		log.Fatalf("Failed to %s: %v", what, err)
+	}
+	result, err := client.WaitForTask(task.TaskUID, 500*time.Millisecond)
+	if err != nil {
+		log.Fatalf("Failed to wait for Meilisearch to %s: %v", what, err)
+	}
+	if result.Status != meilisearch.TaskStatusSucceeded {
+		log.Fatalf("Meilisearch failed to %s: %s", what, result.Error.Message)
+	}
+}
+
+// documentIDs lists the ids of the documents that match filter, or of every document when filter is empty
+func documentIDs(index meilisearch.IndexManager, filter string) []string {
+	var ids []string
+	for _, doc := range documents(index, filter, "id") {
+		ids = append(ids, documentID(doc["id"]))
+	}
+	return ids
+}
+
+// documents lists the given fields of the documents that match filter, or of every document when filter is empty
+func documents(index meilisearch.IndexManager, filter string, fields ...string) []map[string]interface{} {
+	const pageSize = 1000
+	var docs []map[string]interface{}
+	for offset := int64(0); ; offset += pageSize {
+		query := &meilisearch.DocumentsQuery{Offset: offset, Limit: pageSize, Fields: fields}
+		if filter != "" {
+			query.Filter = filter
+		}
+		var res meilisearch.DocumentsResult
+		if err := index.GetDocuments(query, &res); err != nil {
+			log.Fatalf("Failed to list documents: %v", err)
+		}
+		for _, doc := range res.Results {
+			docs = append(docs, doc)
+		}
+		if offset+pageSize >= res.Total {
+			return docs
+		}
+	}
+}
+
+// documentID formats numeric ids without the exponent that %v gives large float64 values
+func documentID(id any) string {
+	if f, ok := id.(float64); ok {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return fmt.Sprint(id)
+}
+
+// deleteMissingCards deletes the documents whose card is no longer in table and returns their ids
+func deleteMissingCards(client meilisearch.ServiceManager, index meilisearch.IndexManager, db *sql.DB, table string) []string {
+	existing := tableIDs(db, table)
+
+	var missing []string
+	for _, id := range documentIDs(index, "") {
+		if !existing[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		task, err := index.DeleteDocuments(missing)
+		waitForTask(client, task, err, "delete removed "+table)
+		fmt.Printf("Deleted %d %s that are no longer in the database\n", len(missing), table)
+	}
+	return missing
+}
+
+func tableIDs(db *sql.DB, table string) map[string]bool {
+	rows, err := db.Query(`SELECT id FROM ` + table)
+	if err != nil {
+		log.Fatalf("Failed to query %s ids: %v", table, err)
+	}
+	defer rows.Close()
+
+	ids := make(map[string]bool)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			log.Fatalf("Failed to scan %s id: %v", table, err)
+		}
+		ids[strconv.FormatInt(id, 10)] = true
+	}
+	if err := rows.Err(); err != nil {
+		log.Fatalf("Failed to query %s ids: %v", table, err)
+	}
+	return ids
+}
+
+// Deleting a child row or a translation changes no updated_at, so the changed-rows conditions
+// cannot see it. The sweeps below compare the indexed documents with MySQL instead and return
+// the cards to index again.
+
+// staleTranslationIDs lists the cards whose indexed English name is no longer the translation of their name
+func staleTranslationIDs(index meilisearch.IndexManager, en translations) []string {
+	var ids []string
+	for _, doc := range documents(index, "", "id", "name", "name_en") {
+		name, _ := doc["name"].(string)
+		nameEn, _ := doc["name_en"].(string)
+		if nameEn != en.text("card_name", name) {
+			ids = append(ids, documentID(doc["id"]))
+		}
+	}
+	return ids
+}
+
+// staleEffectPokemonIDs lists the Pokémon with an attack deleted from pokemon_attacks,
+// or with an attack or ability whose English name is no longer the translation
+func staleEffectPokemonIDs(index meilisearch.IndexManager, db *sql.DB, en translations) []string {
+	attackIDs := tableIDs(db, "pokemon_attacks")
+	seen := make(map[string]bool)
+	var ids []string
+	for _, doc := range documents(index, "", "id", "pokemon_id", "name", "name_en") {
+		name, _ := doc["name"].(string)
+		nameEn, _ := doc["name_en"].(string)
+		stale := nameEn != en.text("effect_name", name)
+		if attackID, ok := strings.CutPrefix(documentID(doc["id"]), "attack-"); ok && !attackIDs[attackID] {
+			stale = true
+		}
+		pokemonID := documentID(doc["pokemon_id"])
+		if stale && !seen[pokemonID] {
+			seen[pokemonID] = true
+			ids = append(ids, pokemonID)
+		}
+	}
+	return ids
+}
+
+// orIDs widens a changed-rows condition to the rows with the given ids
+func orIDs(condition string, args []any, ids []string) (string, []any) {
+	if len(ids) == 0 {
+		return condition, args
+	}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return condition + "\n\tOR id IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ") + ")", args
+}
+
+// inFilter builds `attr IN [..]` filters, split so that a filter never grows too long
+func inFilter(attr string, values []string) []string {
+	const chunkSize = 500
+	var filters []string
+	for start := 0; start < len(values); start += chunkSize {
+		end := min(start+chunkSize, len(values))
+		filters = append(filters, fmt.Sprintf("%s IN [%s]", attr, strings.Join(values[start:end], ", ")))
+	}
+	return filters
+}
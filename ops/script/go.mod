@@ -1,15 +1,18 @@
 module script
 
-go 1.20
+go 1.22.3
 
 require (
-	github.com/go-sql-driver/mysql v1.7.1
+	github.com/go-sql-driver/mysql v1.8.1
 	github.com/meilisearch/meilisearch-go v0.31.0
 	github.com/spf13/cobra v1.9.1
 	github.com/spf13/viper v1.18.2
 )
 
+require filippo.io/edwards25519 v1.1.0 // indirect
+
 require (
+	api v0.0.0-00010101000000-000000000000
 	github.com/andybalholm/brotli v1.1.1 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/golang-jwt/jwt/v4 v4.5.1 // indirect
@@ -30,8 +33,10 @@ require (
 	go.uber.org/atomic v1.9.0 // indirect
 	go.uber.org/multierr v1.9.0 // indirect
 	golang.org/x/exp v0.0.0-20230905200255-921286631fa9 // indirect
-	golang.org/x/sys v0.15.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace api => ../../api